@@ -49,16 +49,28 @@ type analyzerFlags struct {
 
 	// Suggest writes a file with suggestions.
 	Suggest string
+
+	// SuggestUpdate merges the suggestions into the existing suggestion file instead of appending them.
+	SuggestUpdate bool
+
+	// SuggestGo writes the suggestions as `var _ error = ...` assertions into each analyzed package.
+	SuggestGo bool
+
+	// Vote re-runs the analysis with the usage observed in all root packages deciding undetermined types.
+	Vote bool
 }
 
 // defaultFlags are the default setting for the command line flags.
 func defaultFlags() *analyzerFlags {
 	return &analyzerFlags{
-		IncludeTests: true,
-		JSON:         false,
-		Context:      -1,
-		Fix:          false,
-		Diff:         false,
+		IncludeTests:  true,
+		JSON:          false,
+		Context:       -1,
+		Fix:           false,
+		Diff:          false,
+		SuggestUpdate: false,
+		SuggestGo:     false,
+		Vote:          false,
 	}
 }
 
@@ -95,6 +107,11 @@ func setFlags(analyzers []*analysis.Analyzer) *analyzerFlags {
 	// flag.BoolVar(&f.Fix, "fix", f.Fix, "apply all suggested fixes")
 	// flag.BoolVar(&f.Diff, "diff", f.Diff, "with -fix, don't update the files, but print a unified diff")
 	flag.StringVar(&f.Suggest, "suggest", f.Suggest, "append override suggestions to this file, - for standard output")
+	flag.BoolVar(&f.SuggestUpdate, "suggest-update", f.SuggestUpdate,
+		"update the -suggest file in place, merging suggestions and removing redundant overrides read from it")
+	flag.BoolVar(&f.SuggestGo, "suggest-go", f.SuggestGo,
+		"write or update "+goSuggestionsFile+" with var _ error assertions of the suggested usages in each analyzed package")
+	flag.BoolVar(&f.Vote, "vote", f.Vote, "decide undetermined error types by their usage in all analyzed packages")
 
 	return f
 }
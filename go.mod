@@ -7,10 +7,8 @@ toolchain go1.25.0
 require (
 	github.com/goccy/go-yaml v1.18.0
 	golang.org/x/exp v0.0.0-20250813145105-42675adae3e6
+	golang.org/x/mod v0.27.0
 	golang.org/x/tools v0.36.0
 )
 
-require (
-	golang.org/x/mod v0.27.0 // indirect
-	golang.org/x/sync v0.16.0 // indirect
-)
+require golang.org/x/sync v0.16.0 // indirect
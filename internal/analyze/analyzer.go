@@ -23,6 +23,8 @@ import (
 	"golang.org/x/tools/go/analysis/passes/inspect"
 
 	"fillmore-labs.com/errortype/internal/detect"
+	"fillmore-labs.com/errortype/internal/errorchain"
+	"fillmore-labs.com/errortype/internal/flow"
 )
 
 // Documentation constants.
@@ -48,9 +50,12 @@ the 'error' interface without providing their own Error() method.`
 func New(opts ...Option) *analysis.Analyzer {
 	o := makeOptions(opts)
 
-	detectAnalyzer := o.detecttypes
-	if detectAnalyzer == nil {
-		detectAnalyzer = detect.New()
+	if o.detecttypes == nil {
+		o.detecttypes = detect.New()
+	}
+
+	if o.errorchain == nil {
+		o.errorchain = errorchain.New()
 	}
 
 	a := &analysis.Analyzer{
@@ -58,14 +63,16 @@ func New(opts ...Option) *analysis.Analyzer {
 		Doc:        Doc,
 		URL:        URL,
 		Run:        o.run,
-		Requires:   []*analysis.Analyzer{inspect.Analyzer, detectAnalyzer},
+		Requires:   []*analysis.Analyzer{inspect.Analyzer, flow.Analyzer, o.detecttypes, o.errorchain},
 		ResultType: reflect.TypeFor[Result](),
 	}
 
 	a.Flags.BoolVar(&o.styleCheck, "stylecheck", o.styleCheck, "style check (default true)")
+	a.Flags.Func("min-confidence", "only report on usages determined with at least this confidence"+
+		" (\"explicit\", \"usage\", \"heuristic\", default: \"heuristic\")", o.setMinConfidence)
 
 	return a
 }
 
 // Analyzer is a pre-configured *analysis.Analyzer for detecting and enforcing consistent error type usage in Go programs.
-var Analyzer = New(WithDetectTypes(detect.Analyzer))
+var Analyzer = New(WithDetectTypes(detect.Analyzer), WithErrorChain(errorchain.Analyzer))
@@ -24,6 +24,9 @@ import (
 
 	. "fillmore-labs.com/errortype/internal/analyze"
 	"fillmore-labs.com/errortype/internal/detect"
+	"fillmore-labs.com/errortype/internal/errorchain"
+	"fillmore-labs.com/errortype/internal/errortypes"
+	"fillmore-labs.com/errortype/internal/overrides"
 	"fillmore-labs.com/errortype/internal/typeutil"
 )
 
@@ -45,3 +48,79 @@ func TestAnalyzer(t *testing.T) {
 
 	analysistest.Run(t, testdata, a, "test/...")
 }
+
+func TestImpossible(t *testing.T) {
+	t.Parallel()
+
+	if err := typeutil.HasGo(); err != nil {
+		t.Skipf("Go not available: %s", err)
+	}
+
+	testdata := analysistest.TestData()
+
+	a := New(WithErrorChain(errorchain.New(errorchain.WithImpossible(true))))
+
+	analysistest.Run(t, path.Join(testdata, "imp"), a, "imp/...")
+}
+
+func TestVote(t *testing.T) {
+	t.Parallel()
+
+	if err := typeutil.HasGo(); err != nil {
+		t.Skipf("Go not available: %s", err)
+	}
+
+	testdata := analysistest.TestData()
+
+	vote := &detect.Vote{}
+	vote.Set([]overrides.Override{
+		{TypeName: typeutil.TypeName{Path: "vote/lib", Name: "VoteError"}, ErrorType: errortypes.PointerType},
+	})
+
+	a := New(WithDetectTypes(detect.New(detect.WithVote(vote))))
+
+	analysistest.Run(t, path.Join(testdata, "vote"), a, "vote/...")
+}
+
+func TestMinConfidence(t *testing.T) {
+	t.Parallel()
+
+	if err := typeutil.HasGo(); err != nil {
+		t.Skipf("Go not available: %s", err)
+	}
+
+	testdata := analysistest.TestData()
+
+	a := New(WithMinConfidence(errortypes.ExplicitConfidence))
+
+	analysistest.Run(t, path.Join(testdata, "conf"), a, "conf/...")
+}
+
+func TestEvidence(t *testing.T) {
+	t.Parallel()
+
+	if err := typeutil.HasGo(); err != nil {
+		t.Skipf("Go not available: %s", err)
+	}
+
+	testdata := analysistest.TestData()
+
+	results := analysistest.Run(t, path.Join(testdata, "evid"), New(), "evid/...")
+
+	// The package and its test variant both see the uses in evid.go.
+	var evidence Evidence
+
+	for _, r := range results {
+		if result, ok := r.Result.(Result); ok {
+			evidence = evidence.Merge(result.Evidence[typeutil.TypeName{Path: "evid/lib", Name: "EvidError"}])
+		}
+	}
+
+	if evidence.PointerUses != 1 || evidence.ValueUses != 2 {
+		t.Errorf("Got %d pointer and %d value uses, expected 1 and 2", evidence.PointerUses, evidence.ValueUses)
+	}
+
+	if got, want := path.Base(evidence.FirstValue.Filename), "evid.go"; got != want {
+		t.Errorf("Got first value use in %s, expected %s", got, want)
+	}
+}
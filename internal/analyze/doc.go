@@ -23,6 +23,7 @@
 //   - Incorrect type assertions on error types
 //   - Misuse of errors.As with wrong pointer/value semantics
 //   - Switch statements with inconsistent error type handling
+//   - Error values created inconsistently and passed on through local variables
 //
 // This package integrates with the golang.org/x/tools/go/analysis framework
 // and depends on the internal/detect package for error type discovery.
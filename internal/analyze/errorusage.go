@@ -17,6 +17,7 @@
 package analyze
 
 import (
+	"go/token"
 	"go/types"
 
 	"fillmore-labs.com/errortype/internal/typeutil"
@@ -24,8 +25,8 @@ import (
 
 // checkErrorUsage verifies that a given type `t` is used correctly (as a pointer or value)
 // based on the determined or configured usage. It reports diagnostics for mismatches
-// or for types with undetermined usage.
-func (p pass) checkErrorUsage(t types.Type, reporter UsageReporter) {
+// or for types with undetermined usage. pos is the position of the use.
+func (p pass) checkErrorUsage(pos token.Pos, t types.Type, reporter UsageReporter) {
 	if types.IsInterface(t) {
 		return // We can't analyze interfaces.
 	}
@@ -42,7 +43,11 @@ func (p pass) checkErrorUsage(t types.Type, reporter UsageReporter) {
 	}
 
 	// Record the observed usage and look up the expected one.
-	usage := p.recordAndLookup(tn, isPtr)
+	usage := p.recordAndLookup(tn, isPtr, pos)
+
+	if usage&(PointerExpected|ValueExpected) != 0 && p.decisions[tn].Confidence < p.minConf {
+		return // The usage is not established firmly enough to report on.
+	}
 
 	// Check the actual usage against the expected usage.
 	switch usage {
@@ -73,14 +78,16 @@ func (p pass) checkErrorUsage(t types.Type, reporter UsageReporter) {
 
 // recordAndLookup records the observed usage type (value or pointer) for the given
 // type name and returns the detected usage for that type, masked by AnalyzeMask.
-// It updates the errorUsages property with the observed usage before returning the result.
-func (p pass) recordAndLookup(tn *types.TypeName, isPtr bool) Usage {
+// It updates the errorUsages property and the evidence with the observed usage before returning the result.
+func (p pass) recordAndLookup(tn *types.TypeName, isPtr bool, pos token.Pos) Usage {
 	// Record the observed ...
 	et := ValueObserved
 	if isPtr {
 		et = PointerObserved
 	}
 
+	p.evidence.record(tn, isPtr, p.Fset.Position(pos))
+
 	// ... and look up the configured usage for the type.
 	return p.errorUsages.AddTypeProperty(tn, et) & ExpectedMask
 }
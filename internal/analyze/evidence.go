@@ -0,0 +1,148 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyze
+
+import (
+	"cmp"
+	"fmt"
+	"go/token"
+	"go/types"
+	"slices"
+	"strings"
+)
+
+// Evidence counts the observed pointer and value uses of a type.
+type Evidence struct {
+	// PointerUses is the number of observed pointer uses.
+	PointerUses int
+	// ValueUses is the number of observed value uses.
+	ValueUses int
+	// FirstPointer is the position of the first pointer use, if any.
+	FirstPointer token.Position
+	// FirstValue is the position of the first value use, if any.
+	FirstValue token.Position
+
+	// pointers and values are the positions of the uses, so that uses seen by several
+	// analysis passes, like of a package and its test variant, are counted once.
+	pointers, values []token.Position
+}
+
+// Merge combines the evidence of two analysis passes, counting uses at the same position once.
+func (e Evidence) Merge(other Evidence) Evidence {
+	return Evidence{
+		FirstPointer: first(e.FirstPointer, other.FirstPointer),
+		FirstValue:   first(e.FirstValue, other.FirstValue),
+		pointers:     slices.Concat(e.pointers, other.pointers),
+		values:       slices.Concat(e.values, other.values),
+	}.compact()
+}
+
+// compact sorts the positions of the uses, removes duplicates, and counts them.
+func (e Evidence) compact() Evidence {
+	e.pointers = compactPositions(e.pointers)
+	e.values = compactPositions(e.values)
+	e.PointerUses, e.ValueUses = len(e.pointers), len(e.values)
+
+	return e
+}
+
+// String returns a summary of the evidence, like “14 pointer uses (first: pkg/a/x.go:31), 2 value uses (first: ...)”.
+func (e Evidence) String() string {
+	var parts []string
+
+	if e.PointerUses > 0 {
+		parts = append(parts, uses(e.PointerUses, "pointer", e.FirstPointer))
+	}
+
+	if e.ValueUses > 0 {
+		parts = append(parts, uses(e.ValueUses, "value", e.FirstValue))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// Counts returns the number of uses, like “14 pointer uses, 2 value uses”, without positions.
+func (e Evidence) Counts() string {
+	return Evidence{PointerUses: e.PointerUses, ValueUses: e.ValueUses}.String()
+}
+
+// uses formats the number and first position of uses.
+func uses(n int, kind string, pos token.Position) string {
+	s := "s"
+	if n == 1 {
+		s = ""
+	}
+
+	if !pos.IsValid() {
+		return fmt.Sprintf("%d %s use%s", n, kind, s)
+	}
+
+	return fmt.Sprintf("%d %s use%s (first: %s:%d)", n, kind, s, pos.Filename, pos.Line)
+}
+
+// first returns the earlier of two positions, ignoring invalid ones.
+func first(a, b token.Position) token.Position {
+	switch {
+	case !a.IsValid():
+		return b
+
+	case !b.IsValid():
+		return a
+	}
+
+	if comparePositions(a, b) > 0 {
+		return b
+	}
+
+	return a
+}
+
+// comparePositions orders positions by file name, line, and column.
+func comparePositions(a, b token.Position) int {
+	return cmp.Or(
+		strings.Compare(a.Filename, b.Filename),
+		cmp.Compare(a.Line, b.Line),
+		cmp.Compare(a.Column, b.Column),
+	)
+}
+
+// compactPositions sorts positions and removes duplicates in place.
+func compactPositions(positions []token.Position) []token.Position {
+	slices.SortFunc(positions, comparePositions)
+
+	return slices.CompactFunc(positions, func(a, b token.Position) bool { return comparePositions(a, b) == 0 })
+}
+
+// evidenceMap collects the evidence of types during an analysis pass.
+type evidenceMap map[*types.TypeName]*Evidence
+
+// record adds an observed use of a type at the given position. The uses are counted by [Evidence.compact].
+func (m evidenceMap) record(tn *types.TypeName, isPtr bool, pos token.Position) {
+	e, ok := m[tn]
+	if !ok {
+		e = &Evidence{}
+		m[tn] = e
+	}
+
+	if isPtr {
+		e.pointers = append(e.pointers, pos)
+		e.FirstPointer = first(e.FirstPointer, pos)
+	} else {
+		e.values = append(e.values, pos)
+		e.FirstValue = first(e.FirstValue, pos)
+	}
+}
@@ -37,5 +37,9 @@ func (p pass) handleTypeAssert(n *ast.TypeAssertExpr) {
 		p.ReportErrorf(n.Type, "Expected type, got %#v", tv)
 	}
 
-	p.checkErrorUsage(tv.Type, p.AssertReporter(n.Type))
+	if imp, ok := p.chain.Find(n.Lparen, tv.Type); ok {
+		p.ImpossibleReporter(n.Type, imp).AssertionNeverSucceeds(tv.Type)
+	}
+
+	p.checkErrorUsage(n.Type.Pos(), tv.Type, p.AssertReporter(n.Type))
 }
@@ -32,19 +32,24 @@ func (p pass) handleErrorsAs(n *ast.CallExpr, styleCheck bool) {
 	}
 
 	// Retrieve the definition of the called function.
-	fun, targetExpr, targetArgIndex := typeutil.IsErrorAs(p.TypesInfo, n)
+	fun, targetExpr, targetArgIndex := p.funcValues.IsErrorAs(p.TypesInfo, n)
 
 	if fun == nil {
 		return // Not an errors.As-like function.
 	}
 
+	// The inspected error precedes the target argument or comes first for generic functions.
+	if errArgIndex := max(targetArgIndex-1, 0); errArgIndex < len(n.Args) {
+		p.checked[ast.Unparen(n.Args[errArgIndex])] = struct{}{}
+	}
+
 	if targetExpr != nil {
 		reporter := p.GenericReporter(targetExpr, fun)
 
 		targetType := p.TypesInfo.Types[targetExpr].Type
 
 		// Now, check if the error type is used correctly (pointer vs. value).
-		p.checkErrorUsage(targetType, reporter)
+		p.checkErrorUsage(targetExpr.Pos(), targetType, reporter)
 
 		return
 	}
@@ -87,10 +92,14 @@ func (p pass) handleErrorsAs(n *ast.CallExpr, styleCheck bool) {
 			break
 		}
 
+		if imp, ok := p.chain.Find(n.Lparen, elemType); ok {
+			p.ImpossibleReporter(targetArg, imp).TargetNeverMatches(elemType)
+		}
+
 		reporter := p.ErrorsAsReporter(targetArg, fun)
 
 		// Now, check if the error type is used correctly (pointer vs. value).
-		p.checkErrorUsage(elemType, reporter)
+		p.checkErrorUsage(targetArg.Pos(), elemType, reporter)
 
 		if styleCheck {
 			reporter.CheckStyle(elemType)
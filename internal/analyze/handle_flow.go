@@ -0,0 +1,76 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyze
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/ssa"
+
+	"fillmore-labs.com/errortype/internal/flow"
+)
+
+// handleFlows checks concrete error values that reach a return, a type assertion or
+// an errors.As-like function through local variables. Mismatches are reported where
+// the value is created, unless the expression is part of an error directly returned or
+// inspected, which is handled by the AST checks.
+func (p pass) handleFlows(in *inspector.Inspector, funcs []*ssa.Function) {
+	seen := make(map[ast.Expr]struct{})
+
+	for _, fn := range funcs {
+		for source := range flow.Sources(fn) {
+			expr := source.Expr
+			if expr == nil {
+				continue // Can't locate the creation site.
+			}
+
+			if _, ok := seen[expr]; ok {
+				continue
+			}
+
+			seen[expr] = struct{}{}
+
+			if p.isDirect(in, expr) {
+				continue // Already checked as a return value or used directly in errors.As.
+			}
+
+			p.checkErrorUsage(expr.Pos(), source.Value.Type(), p.FlowReporter(expr, source.Sink.String()))
+		}
+	}
+}
+
+// isDirect reports whether expr is part of an expression directly returned or inspected.
+func (p pass) isDirect(in *inspector.Inspector, expr ast.Expr) bool {
+	c, ok := in.Root().FindNode(expr)
+	if !ok {
+		return false
+	}
+
+	for {
+		e, ok := c.Node().(ast.Expr)
+		if !ok {
+			return false
+		}
+
+		if _, ok := p.checked[e]; ok {
+			return true
+		}
+
+		c = c.Parent()
+	}
+}
@@ -16,28 +16,61 @@
 
 package analyze
 
-import "golang.org/x/tools/go/ast/inspector"
+import (
+	"go/ast"
 
-// handleReturns identifies function return parameters that are of type error
-// and then inspects all return statements within the function body to check
-// for incorrect error type usage.
-func (p pass) handleReturns(b inspector.Cursor, lastResult int) {
-	for retStmt := range AllReturns(b) {
-		if len(retStmt.Results) <= lastResult {
-			continue // Skip return statements with differing arity
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/errortype/internal/typeutil"
+)
+
+// errorResults returns the error results of a function and records its named error results,
+// so that assignments to them are checked as return sites.
+func (p pass) errorResults(ft *ast.FuncType) []typeutil.ErrorResult {
+	results := typeutil.ErrorResults(p.TypesInfo, ft.Results)
+	for _, result := range results {
+		if result.Var != nil {
+			p.results[result.Var] = struct{}{}
 		}
+	}
 
-		res := retStmt.Results[lastResult]
+	return results
+}
 
-		resType := p.TypesInfo.Types[res]
-		if !resType.IsValue() { // should not happen
-			p.ReportErrorf(res, "Expected value, got %#v", resType)
+// handleReturns inspects all return statements within the function body to check
+// the given error results for incorrect error type usage.
+func (p pass) handleReturns(b inspector.Cursor, results []typeutil.ErrorResult, numResults int) {
+	for retStmt := range AllReturns(b) {
+		if len(retStmt.Results) != numResults {
+			continue // Skip naked returns and return statements with differing arity
 		}
 
-		if resType.IsNil() {
-			continue // nil is fine.
+		for _, result := range results {
+			p.checkResult(retStmt.Results[result.Index])
 		}
+	}
+}
 
-		p.checkErrorUsage(resType.Type, p.ReturnReporter(res))
+// handleResultAssign checks assignments to named error results, e.g., in deferred closures,
+// which are returned like the results of a return statement.
+func (p pass) handleResultAssign(n *ast.AssignStmt) {
+	for _, res := range typeutil.AssignedErrorResults(p.TypesInfo, n, p.results) {
+		p.checkResult(res)
 	}
 }
+
+// checkResult checks a returned error expression.
+func (p pass) checkResult(res ast.Expr) {
+	resType := p.TypesInfo.Types[res]
+	if !resType.IsValue() { // should not happen
+		p.ReportErrorf(res, "Expected value, got %#v", resType)
+	}
+
+	if resType.IsNil() {
+		return // nil is fine.
+	}
+
+	p.checked[ast.Unparen(res)] = struct{}{}
+
+	p.checkErrorUsage(res.Pos(), resType.Type, p.ReturnReporter(res))
+}
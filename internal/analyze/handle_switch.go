@@ -63,8 +63,12 @@ func (p pass) handleTypeSwitch(n *ast.TypeSwitchStmt) {
 				continue
 			}
 
+			if imp, ok := p.chain.Find(clause.Case, caseType.Type); ok {
+				p.ImpossibleReporter(caseExpr, imp).AssertionNeverSucceeds(caseType.Type)
+			}
+
 			// Perform the pointer-vs-value analysis on the case type.
-			p.checkErrorUsage(caseType.Type, p.SwitchReporter(caseExpr))
+			p.checkErrorUsage(caseExpr.Pos(), caseType.Type, p.SwitchReporter(caseExpr))
 		}
 	}
 }
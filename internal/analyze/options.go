@@ -17,23 +17,32 @@
 package analyze
 
 import (
+	"fmt"
 	"log/slog"
 
 	"golang.org/x/tools/go/analysis"
+
+	"fillmore-labs.com/errortype/internal/errortypes"
 )
 
 type options struct {
 	detecttypes *analysis.Analyzer
+	errorchain  *analysis.Analyzer
 
 	// styleCheck controls style check
 	styleCheck bool
+
+	// minConfidence is the minimum confidence of determined usages to report on
+	minConfidence errortypes.Confidence
 }
 
 // defaultOptions returns a [options] struct initialized with default values.
 func defaultOptions() *options {
 	return &options{ // Default options
-		detecttypes: nil,
-		styleCheck:  true,
+		detecttypes:   nil,
+		errorchain:    nil,
+		styleCheck:    true,
+		minConfidence: errortypes.HeuristicConfidence,
 	}
 }
 
@@ -89,6 +98,20 @@ func (o detectTypesOption) key() string { return "detect" }
 
 func (o detectTypesOption) apply(opts *options) { opts.detecttypes = o.detecttypes }
 
+// WithErrorChain sets a custom *analysis.Analyzer for determining the error types returned by functions.
+func WithErrorChain(errorchain *analysis.Analyzer) Option {
+	return errorChainOption{errorchain: errorchain}
+}
+
+type errorChainOption struct{ errorchain *analysis.Analyzer }
+
+// LogValue implements the [slog.LogValuer] interface.
+func (o errorChainOption) LogValue() slog.Value { return slog.StringValue(o.errorchain.Name) }
+
+func (o errorChainOption) key() string { return "errorchain" }
+
+func (o errorChainOption) apply(opts *options) { opts.errorchain = o.errorchain }
+
 // WithStyleCheck is an [Option] to configure style check.
 func WithStyleCheck(styleCheck bool) Option { return styleCheckOption{styleCheck: styleCheck} }
 
@@ -100,3 +123,35 @@ func (o styleCheckOption) LogValue() slog.Value { return slog.BoolValue(o.styleC
 func (o styleCheckOption) key() string { return "stylecheck" }
 
 func (o styleCheckOption) apply(opts *options) { opts.styleCheck = o.styleCheck }
+
+// WithMinConfidence is an [Option] to only report on usages determined with at least the given confidence.
+func WithMinConfidence(confidence errortypes.Confidence) Option {
+	return minConfidenceOption{confidence: confidence}
+}
+
+type minConfidenceOption struct{ confidence errortypes.Confidence }
+
+// LogValue implements the [slog.LogValuer] interface.
+func (o minConfidenceOption) LogValue() slog.Value { return slog.StringValue(o.confidence.String()) }
+
+func (o minConfidenceOption) key() string { return "min-confidence" }
+
+func (o minConfidenceOption) apply(opts *options) { opts.minConfidence = o.confidence }
+
+// setMinConfidence parses and sets the minimum confidence.
+// Valid values are: "explicit", "usage", and "heuristic".
+func (o *options) setMinConfidence(value string) error {
+	for _, confidence := range [...]errortypes.Confidence{
+		errortypes.ExplicitConfidence,
+		errortypes.UsageConfidence,
+		errortypes.HeuristicConfidence,
+	} {
+		if value == confidence.String() {
+			o.minConfidence = confidence
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown confidence %q", value) //nolint:err113
+}
@@ -17,9 +17,15 @@
 package analyze
 
 import (
+	"go/ast"
+	"go/types"
+
 	"golang.org/x/tools/go/analysis"
 
+	"fillmore-labs.com/errortype/internal/analyze/report"
+	"fillmore-labs.com/errortype/internal/errorchain"
 	"fillmore-labs.com/errortype/internal/errortypes"
+	"fillmore-labs.com/errortype/internal/typeutil"
 )
 
 // pass wraps an *analysis.pass and tracks error usages within the analysis pass.
@@ -27,6 +33,13 @@ import (
 type pass struct {
 	*analysis.Pass
 	errorUsages errortypes.PropertyMap[Usage]
+	funcValues  typeutil.FuncValues
+	checked     map[ast.Expr]struct{}               // Expressions directly used as returned or inspected errors.
+	results     map[*types.Var]struct{}             // Named error results of all functions seen so far.
+	chain       errorchain.Result                   // Targets that can never match.
+	decisions   map[*types.TypeName]report.Decision // How the usage of types was determined.
+	evidence    evidenceMap                         // Observed uses of types.
+	minConf     errortypes.Confidence               // Minimum confidence of usages to report on.
 }
 
 // newPass creates and returns a new Pass instance, initializing its errorUsages property map.
@@ -35,5 +48,10 @@ func newPass(ap *analysis.Pass) pass {
 	return pass{
 		Pass:        ap,
 		errorUsages: errortypes.NewPropertyMap[Usage](),
+		funcValues:  typeutil.NewFuncValues(ap.TypesInfo, ap.Files),
+		checked:     make(map[ast.Expr]struct{}),
+		results:     make(map[*types.Var]struct{}),
+		decisions:   make(map[*types.TypeName]report.Decision),
+		evidence:    make(evidenceMap),
 	}
 }
@@ -22,9 +22,9 @@ import (
 	"golang.org/x/tools/go/ast/edge"
 	"golang.org/x/tools/go/ast/inspector"
 
+	"fillmore-labs.com/errortype/internal/analyze/report"
 	"fillmore-labs.com/errortype/internal/detect"
 	"fillmore-labs.com/errortype/internal/errortypes"
-	"fillmore-labs.com/errortype/internal/typeutil"
 )
 
 // processDetectedTypes populates the initial error usage map based on the results
@@ -48,6 +48,10 @@ func (p pass) processDetectedTypes(resultInfo []detect.ResultInfo) {
 		}
 
 		p.errorUsages[detectedType.TypeName] = usage
+		p.decisions[detectedType.TypeName] = report.Decision{
+			Confidence: detectedType.Confidence,
+			Vote:       detectedType.Vote,
+		}
 	}
 }
 
@@ -56,6 +60,7 @@ func (p pass) processDetectedTypes(resultInfo []detect.ResultInfo) {
 // corresponding handler function.
 func (p pass) processAST(in *inspector.Inspector, styleCheck bool) {
 	for c := range in.Root().Preorder(
+		(*ast.AssignStmt)(nil),
 		(*ast.CallExpr)(nil),
 		(*ast.FuncDecl)(nil),
 		(*ast.FuncLit)(nil),
@@ -63,6 +68,9 @@ func (p pass) processAST(in *inspector.Inspector, styleCheck bool) {
 		(*ast.TypeSwitchStmt)(nil),
 	) {
 		switch n := c.Node().(type) {
+		case *ast.AssignStmt:
+			p.handleResultAssign(n)
+
 		case *ast.CallExpr:
 			p.handleErrorsAs(n, styleCheck)
 
@@ -71,15 +79,15 @@ func (p pass) processAST(in *inspector.Inspector, styleCheck bool) {
 				continue // Skip function declarations without a body.
 			}
 
-			if lastResult := typeutil.HasErrorResult(p.TypesInfo, n.Type.Results); lastResult >= 0 {
+			if results := p.errorResults(n.Type); len(results) > 0 {
 				b := c.ChildAt(edge.FuncDecl_Body, -1)
-				p.handleReturns(b, lastResult)
+				p.handleReturns(b, results, n.Type.Results.NumFields())
 			}
 
 		case *ast.FuncLit:
-			if lastResult := typeutil.HasErrorResult(p.TypesInfo, n.Type.Results); lastResult >= 0 {
+			if results := p.errorResults(n.Type); len(results) > 0 {
 				b := c.ChildAt(edge.FuncLit_Body, -1)
-				p.handleReturns(b, lastResult)
+				p.handleReturns(b, results, n.Type.Results.NumFields())
 			}
 
 		case *ast.TypeAssertExpr:
@@ -27,14 +27,14 @@ type Assert struct {
 func (r Assert) ShouldBeValue(tn *types.TypeName) {
 	fullName, importName := r.relativeNameOf(tn), r.importNameOf(tn)
 	// "_, ok := err.(*MyValueError)" or "case *MyValueError:"
-	r.ReportRangef(r.Expr,
-		`Error type %q should be asserted as a value ("err.(%s)"), not a pointer. (et:ast)`, fullName, importName)
+	r.reportUsagef(tn, "ast",
+		`Error type %q should be asserted as a value ("err.(%s)"), not a pointer.`, fullName, importName)
 }
 
 // ShouldBePointer reports a diagnostic when a pointer error is asserted as a value.
 func (r Assert) ShouldBePointer(tn *types.TypeName) {
 	fullName, importName := r.relativeNameOf(tn), r.importNameOf(tn)
 	// "_, ok := err.(MyPointerError)"" or "case MyPointerError:""
-	r.ReportRangef(r.Expr,
-		`Error type %q should be asserted as a pointer ("err.(*%s)"), not a value. (et:ast+)`, fullName, importName)
+	r.reportUsagef(tn, "ast+",
+		`Error type %q should be asserted as a pointer ("err.(*%s)"), not a value.`, fullName, importName)
 }
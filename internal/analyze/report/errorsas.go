@@ -30,7 +30,7 @@ func (r ErrorsAs) ShouldBeValue(tn *types.TypeName) {
 	fname, varname := r.funName(), r.varName()
 
 	// errors.As(err, &p) where p is *ValueError. Target is **ValueError, but should be *ValueError.
-	r.ReportRangef(r.Expr, `Target for value error %q is a pointer-to-pointer, use a pointer to a value instead: "var %s %s; ... %s(err, &%s)". (et:err)`,
+	r.reportUsagef(tn, "err", `Target for value error %q is a pointer-to-pointer, use a pointer to a value instead: "var %s %s; ... %s(err, &%s)".`,
 		fullName, varname, importName, fname, varname)
 }
 
@@ -40,7 +40,7 @@ func (r ErrorsAs) ShouldBePointer(tn *types.TypeName) {
 	fname, varname := r.funName(), r.varName()
 
 	// errors.As(err, &p) where p is PointerError. Target is *PointerError, but should be **PointerError.
-	r.ReportRangef(r.Expr, `Target for pointer error %q is a pointer-to-value, use a pointer to a pointer instead: "var %s *%s; ... %s(err, &%s)". (et:err+)`,
+	r.reportUsagef(tn, "err+", `Target for pointer error %q is a pointer-to-value, use a pointer to a pointer instead: "var %s *%s; ... %s(err, &%s)".`,
 		fullName, varname, importName, fname, varname)
 }
 
@@ -0,0 +1,45 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import "go/types"
+
+// Flow reports diagnostics related to error values reaching a return statement, a type assertion
+// or an errors.As-like function through local variables.
+type Flow struct {
+	Base
+	Sink string
+}
+
+// ShouldBeValue reports a diagnostic when a value error is created as a pointer.
+func (r Flow) ShouldBeValue(tn *types.TypeName) {
+	fullName, importName := r.relativeNameOf(tn), r.importNameOf(tn)
+	// "var err error = &MyValueError{}; ... err.(MyValueError)"
+	r.reportUsagef(tn, "flw",
+		"Error type %q reaches a %s as a pointer, use a value (\"%s{...}\") instead.", fullName, r.Sink, importName)
+}
+
+// ShouldBePointer reports a diagnostic when a pointer error is created as a value.
+func (r Flow) ShouldBePointer(tn *types.TypeName) {
+	fullName, importName := r.relativeNameOf(tn), r.importNameOf(tn)
+	// "var err error = MyPointerError{}; ... err.(*MyPointerError)"
+	r.reportUsagef(tn, "flw+",
+		"Error type %q reaches a %s as a value, use a pointer (\"&%s{...}\") instead.", fullName, r.Sink, importName)
+}
+
+// UndeterminedUsage does not report at creation sites, undetermined types are reported where they are used.
+func (Flow) UndeterminedUsage(*types.TypeName, bool) {}
@@ -29,8 +29,8 @@ func (r Generic) ShouldBeValue(tn *types.TypeName) {
 	fullName, importName := r.relativeNameOf(tn), r.importNameOf(tn)
 	fname := r.funName()
 
-	r.ReportRangef(r.Expr,
-		`Error type %q should be queried as a value ("%s[%s]"), not a pointer. (et:ast)`, fullName, fname, importName)
+	r.reportUsagef(tn, "ast",
+		`Error type %q should be queried as a value ("%s[%s]"), not a pointer.`, fullName, fname, importName)
 }
 
 // ShouldBePointer reports a diagnostic when a pointer error is queried as a value.
@@ -38,8 +38,8 @@ func (r Generic) ShouldBePointer(tn *types.TypeName) {
 	fullName, importName := r.relativeNameOf(tn), r.importNameOf(tn)
 	fname := r.funName()
 
-	r.ReportRangef(r.Expr,
-		`Error type %q should be queried as a pointer ("%s[*%s]"), not a value. (et:ast+)`, fullName, fname, importName)
+	r.reportUsagef(tn, "ast+",
+		`Error type %q should be queried as a pointer ("%s[*%s]"), not a value.`, fullName, fname, importName)
 }
 
 // funName gets a short function name, not necessarily matching imports.
@@ -0,0 +1,64 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"go/types"
+	"strings"
+)
+
+// Impossible reports diagnostics for errors.As targets and type assertions that can never match,
+// since the target type can't be in the chain of the inspected error.
+type Impossible struct {
+	Base
+	Types    []string // The possible types in the chain of the inspected error.
+	Mismatch string   // Module or package path of a different version producing the inspected error.
+}
+
+// TargetNeverMatches reports an errors.As target that can never match.
+func (r Impossible) TargetNeverMatches(target types.Type) {
+	if r.Mismatch != "" {
+		r.ReportRangef(r.Expr, "Target type %q can never match, the inspected error is produced by %q, a different version of its module. (et:imp)",
+			types.TypeString(target, types.RelativeTo(r.Pkg)), r.Mismatch)
+
+		return
+	}
+
+	r.ReportRangef(r.Expr, "Target type %q can never match, the inspected error %s. (et:imp)",
+		types.TypeString(target, types.RelativeTo(r.Pkg)), r.contents())
+}
+
+// AssertionNeverSucceeds reports a type assertion or type switch case that can never match.
+func (r Impossible) AssertionNeverSucceeds(target types.Type) {
+	if r.Mismatch != "" {
+		r.ReportRangef(r.Expr, "Type %q can never match, the asserted error is produced by %q, a different version of its module. (et:imp)",
+			types.TypeString(target, types.RelativeTo(r.Pkg)), r.Mismatch)
+
+		return
+	}
+
+	r.ReportRangef(r.Expr, "Type %q can never match, the asserted error %s. (et:imp)",
+		types.TypeString(target, types.RelativeTo(r.Pkg)), r.contents())
+}
+
+func (r Impossible) contents() string {
+	if len(r.Types) == 0 {
+		return "is always nil"
+	}
+
+	return "only contains " + strings.Join(r.Types, ", ")
+}
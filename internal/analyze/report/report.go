@@ -17,18 +17,49 @@
 package report
 
 import (
+	"fmt"
 	"go/ast"
 	"go/token"
 	"go/types"
+	"strings"
 
 	"golang.org/x/tools/go/analysis"
+
+	"fillmore-labs.com/errortype/internal/errortypes"
 )
 
 // Base provides the basic fields for reporting diagnostics.
 // It holds the analysis pass and the AST node where the diagnostic should be reported.
 type Base struct {
 	*analysis.Pass
-	Expr ast.Expr
+	Expr      ast.Expr
+	Decisions map[*types.TypeName]Decision // How the usage of types was determined.
+}
+
+// Decision describes how the usage of an error type was determined.
+type Decision struct {
+	Confidence errortypes.Confidence // How firmly the usage is established.
+	Vote       bool                  // The usage was determined by a module-wide vote.
+}
+
+// reportUsagef reports a usage diagnostic with the given code for tn at the expression, noting
+// the confidence of the usage of tn and whether it was determined by a module-wide vote.
+func (r Base) reportUsagef(tn *types.TypeName, code, format string, args ...any) {
+	var msg strings.Builder
+
+	fmt.Fprintf(&msg, format, args...)
+
+	if d, ok := r.Decisions[tn]; ok {
+		if d.Vote {
+			msg.WriteString(" Usage determined by module vote.")
+		}
+
+		msg.WriteString(" Confidence: " + d.Confidence.String() + ".")
+	}
+
+	msg.WriteString(" (et:" + code + ")")
+
+	r.Report(analysis.Diagnostic{Pos: r.Expr.Pos(), End: r.Expr.End(), Message: msg.String()})
 }
 
 // UndeterminedUsage reports a diagnostic for an error type with undetermined usage.
@@ -27,14 +27,14 @@ type Return struct {
 func (r Return) ShouldBeValue(tn *types.TypeName) {
 	fullName, importName := r.relativeNameOf(tn), r.importNameOf(tn)
 	// This case handles returning a pointer to a value-error ("return &MyValueError{}")
-	r.ReportRangef(r.Expr,
-		"Error type %q should be returned by value (\"%s{...}\"), not as a pointer. (et:ret)", fullName, importName)
+	r.reportUsagef(tn, "ret",
+		"Error type %q should be returned by value (\"%s{...}\"), not as a pointer.", fullName, importName)
 }
 
 // ShouldBePointer reports a diagnostic when a pointer error is returned as a value.
 func (r Return) ShouldBePointer(tn *types.TypeName) {
 	fullName, importName := r.relativeNameOf(tn), r.importNameOf(tn)
 	// This case handles returning a value of a pointer-error ("return MyPointerError{}")
-	r.ReportRangef(r.Expr,
-		"Error type %q should be returned as a pointer (\"&%s{...}\"), not by value. (et:ret+)", fullName, importName)
+	r.reportUsagef(tn, "ret+",
+		"Error type %q should be returned as a pointer (\"&%s{...}\"), not by value.", fullName, importName)
 }
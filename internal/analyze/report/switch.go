@@ -27,14 +27,14 @@ type Switch struct {
 func (r Switch) ShouldBeValue(tn *types.TypeName) {
 	fullName, importName := r.relativeNameOf(tn), r.importNameOf(tn)
 	// "_, ok := err.(*MyValueError)" or "case *MyValueError:"
-	r.ReportRangef(r.Expr,
-		`Value error %q should be used as a value type ("case %s:") in the type switch, not as a pointer type. (et:ast)`, fullName, importName)
+	r.reportUsagef(tn, "ast",
+		`Value error %q should be used as a value type ("case %s:") in the type switch, not as a pointer type.`, fullName, importName)
 }
 
 // ShouldBePointer reports a diagnostic when a pointer error is asserted as a value.
 func (r Switch) ShouldBePointer(tn *types.TypeName) {
 	fullName, importName := r.relativeNameOf(tn), r.importNameOf(tn)
 	// "_, ok := err.(MyPointerError)"" or "case MyPointerError:""
-	r.ReportRangef(r.Expr,
-		`Pointer error %q should be used as a pointer type ("case *%s:") in the type switch, not as a value type. (et:ast+)`, fullName, importName)
+	r.reportUsagef(tn, "ast+",
+		`Pointer error %q should be used as a pointer type ("case *%s:") in the type switch, not as a value type.`, fullName, importName)
 }
@@ -19,8 +19,10 @@ package analyze
 import (
 	"go/ast"
 	"go/types"
+	"strconv"
 
 	"fillmore-labs.com/errortype/internal/analyze/report"
+	"fillmore-labs.com/errortype/internal/errorchain"
 )
 
 // UsageReporter defines the interface for reporting diagnostics related to
@@ -41,27 +43,57 @@ type UsageReporter interface {
 	UndeterminedUsage(tn *types.TypeName, isPtr bool)
 }
 
+// base creates the common part of reporters for diagnostics at e.
+func (p pass) base(e ast.Expr) report.Base {
+	return report.Base{Pass: p.Pass, Expr: e, Decisions: p.decisions}
+}
+
 // AssertReporter creates a new reporter for assertions.
 func (p pass) AssertReporter(e ast.Expr) report.Assert {
-	return report.Assert{Base: report.Base{Pass: p.Pass, Expr: e}}
+	return report.Assert{Base: p.base(e)}
 }
 
 // ErrorsAsReporter creates a new reporter for errors.As like functions.
 func (p pass) ErrorsAsReporter(e ast.Expr, fun *types.Func) report.ErrorsAs {
-	return report.ErrorsAs{Base: report.Base{Pass: p.Pass, Expr: e}, Fun: fun}
+	return report.ErrorsAs{Base: p.base(e), Fun: fun}
 }
 
 // ReturnReporter creates a new reporter for return statements.
 func (p pass) ReturnReporter(e ast.Expr) report.Return {
-	return report.Return{Base: report.Base{Pass: p.Pass, Expr: e}}
+	return report.Return{Base: p.base(e)}
 }
 
 // SwitchReporter creates a new reporter for type switches.
 func (p pass) SwitchReporter(e ast.Expr) report.Switch {
-	return report.Switch{Base: report.Base{Pass: p.Pass, Expr: e}}
+	return report.Switch{Base: p.base(e)}
+}
+
+// FlowReporter creates a new reporter for error values reaching the given sink through local variables.
+func (p pass) FlowReporter(e ast.Expr, sink string) report.Flow {
+	return report.Flow{Base: p.base(e), Sink: sink}
+}
+
+// ImpossibleReporter creates a new reporter for targets that can never be found in the chain
+// of the inspected error.
+func (p pass) ImpossibleReporter(e ast.Expr, imp errorchain.Impossible) report.Impossible {
+	names := make([]string, 0, len(imp.Types.Types))
+	for _, t := range imp.Types.Types {
+		name := t.Name
+		if t.Path != p.Pkg.Path() {
+			name = t.TypeName.String()
+		}
+
+		if t.Pointer {
+			name = "*" + name
+		}
+
+		names = append(names, strconv.Quote(name))
+	}
+
+	return report.Impossible{Base: p.base(e), Types: names, Mismatch: imp.Mismatch}
 }
 
 // GenericReporter creates a new reporter for generic functions.
 func (p pass) GenericReporter(e ast.Expr, fun *types.Func) report.Generic {
-	return report.Generic{Base: report.Base{Pass: p.Pass, Expr: e}, Fun: fun}
+	return report.Generic{Base: p.base(e), Fun: fun}
 }
@@ -31,15 +31,25 @@ type Result struct {
 
 	// Inconsistent contains type names that were found to be used inconsistently as both pointer and value types.
 	Inconsistent []typeutil.TypeName
+
+	// Evidence contains the observed uses of the types above.
+	Evidence map[typeutil.TypeName]Evidence
 }
 
 // calculateResult analyzes the collected error usages and categorizes them into pointers,
 // values, and inconsistent types.
 func (p pass) calculateResult() Result {
 	var pointers, values, inconsistent []typeutil.TypeName
+
+	evidence := make(map[typeutil.TypeName]Evidence)
+
 	for tn, typ := range p.errorUsages.AllDetermined {
 		typeName := typeutil.NewTypeName(tn)
 
+		if e, ok := p.evidence[tn]; ok {
+			evidence[typeName] = e.compact()
+		}
+
 		switch typ {
 		case errortypes.PointerType:
 			pointers = append(pointers, typeName)
@@ -52,5 +62,5 @@ func (p pass) calculateResult() Result {
 		}
 	}
 
-	return Result{Pointers: pointers, Values: values, Inconsistent: inconsistent}
+	return Result{Pointers: pointers, Values: values, Inconsistent: inconsistent, Evidence: evidence}
 }
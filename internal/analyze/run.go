@@ -24,6 +24,8 @@ import (
 	"golang.org/x/tools/go/ast/inspector"
 
 	"fillmore-labs.com/errortype/internal/detect"
+	"fillmore-labs.com/errortype/internal/errorchain"
+	"fillmore-labs.com/errortype/internal/flow"
 )
 
 // ErrNoInspectorResult is returned when the ast inspector is missing.
@@ -32,6 +34,12 @@ var ErrNoInspectorResult = errors.New("errortype: inspector result missing")
 // ErrNoDetectTypesResult is returned when the result from the detecttypes analyzer is missing.
 var ErrNoDetectTypesResult = errors.New("errortype: detecttypes result missing")
 
+// ErrNoErrorChainResult is returned when the result from the errorchain analyzer is missing.
+var ErrNoErrorChainResult = errors.New("errortype: errorchain result missing")
+
+// ErrNoSSAResult is returned when the result from the SSA analyzer is missing.
+var ErrNoSSAResult = errors.New("errortype: SSA result missing")
+
 // run executes the analysis pass using the provided options. It processes detected types,
 // analyzes the abstract syntax tree (AST), and calculates the final result. If any step fails,
 // an error is returned. Otherwise, the computed result is returned.
@@ -41,17 +49,33 @@ func (o *options) run(ap *analysis.Pass) (any, error) {
 		return nil, ErrNoDetectTypesResult
 	}
 
+	chainResult, ok := ap.ResultOf[o.errorchain].(errorchain.Result)
+	if !ok {
+		return nil, ErrNoErrorChainResult
+	}
+
 	in, ok := ap.ResultOf[inspect.Analyzer].(*inspector.Inspector)
 	if !ok {
 		return nil, ErrNoInspectorResult
 	}
 
+	ssaResult, ok := ap.ResultOf[flow.Analyzer].(*flow.SSA)
+	if !ok {
+		return nil, ErrNoSSAResult
+	}
+
 	p := newPass(ap)
 
+	p.minConf = o.minConfidence
+
+	p.chain = chainResult
+
 	p.processDetectedTypes(detectedResult.Types)
 
 	p.processAST(in, o.styleCheck)
 
+	p.handleFlows(in, ssaResult.SrcFuncs)
+
 	res := p.calculateResult()
 
 	return res, nil
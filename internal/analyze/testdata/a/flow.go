@@ -0,0 +1,68 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"errors"
+	"math/rand/v2"
+
+	"test/a/b"
+)
+
+func FlowReturn() error {
+	var err error = &b.ValueError{} // want " \\(et:flw\\)$"
+
+	return err
+}
+
+func FlowPhi() error {
+	var err error
+	if rand.Int() == 0 {
+		err = b.PointerError{} // want " \\(et:flw\\+\\)$"
+	} else {
+		err = &b.PointerError{}
+	}
+
+	return err
+}
+
+func FlowAssert() {
+	err := error(&b.ValueError{}) // want " \\(et:flw\\)$"
+
+	_, _ = err.(interface{ Temporary() bool })
+}
+
+func FlowErrorsAs() {
+	var err error = b.PointerError{} // want " \\(et:flw\\+\\)$"
+
+	var target *b.PointerError
+	_ = errors.As(err, &target)
+}
+
+func FlowCaptured() error {
+	var err error = &b.ValueError{} // want " \\(et:flw\\)$"
+
+	func() { _ = err }()
+
+	return err
+}
+
+func FlowDirect() error {
+	_ = errors.As(&b.ValueError{}, new(*b.PointerError)) // want " \\(et:sty\\)$"
+
+	return &b.ValueError{} // want " \\(et:ret\\)$"
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type asFuncs struct {
+	as      func(err error, target any) bool
+	unknown func(err error, target any) bool
+}
+
+type ExportedFuncs struct {
+	As func(err error, target any) bool
+}
+
+func FuncValues(t *testing.T, unknown func(err error, target any) bool) {
+	var (
+		err error
+		evp *ErrorsAsValue
+	)
+
+	as := errors.As
+	_ = as(err, &evp) // want " \\(et:err\\)$"
+
+	as2 := as
+	_ = as2(err, &evp) // want " \\(et:err\\)$"
+
+	check := require.New(t).ErrorAs
+	check(err, &evp) // want " \\(et:err\\)$"
+
+	checkExpr := (*require.Assertions).ErrorAs
+	checkExpr(require.New(t), err, &evp) // want " \\(et:err\\)$"
+
+	s := asFuncs{as: errors.As, unknown: unknown}
+	_ = s.as(err, &evp) // want " \\(et:err\\)$"
+	_ = s.unknown(err, &evp)
+
+	e := ExportedFuncs{As: errors.As}
+	_ = e.As(err, &evp) // Exported fields can be written by other packages.
+
+	_ = unknown(err, &evp)
+
+	reassigned := errors.As
+	reassigned = unknown
+	_ = reassigned(err, &evp)
+
+	addressed := errors.As
+	changeFunc(&addressed)
+	_ = addressed(err, &evp)
+}
+
+func changeFunc(f *func(err error, target any) bool) { *f = nil }
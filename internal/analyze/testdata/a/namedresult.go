@@ -0,0 +1,64 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "test/a/b"
+
+func NamedResult() (err error) {
+	err = &b.ValueError{} // want " \\(et:ret\\)$"
+
+	return
+}
+
+func NamedResultDefer() (n int, err error) {
+	defer func() {
+		if err != nil {
+			err = b.PointerError{} // want " \\(et:ret\\+\\)$"
+		}
+	}()
+
+	n, err = 1, nil
+
+	return n, err
+}
+
+func NamedResultShadowed() (err error) {
+	if err := error(&b.ValueError{}); err != nil {
+		return nil
+	}
+
+	{
+		err := &b.ValueError{}
+		_ = err
+	}
+
+	return
+}
+
+func NamedResultRedeclared() (err error) {
+	n, err := 0, b.PointerError{} // want " \\(et:ret\\+\\)$"
+	_ = n
+
+	return
+}
+
+func NamedResults() (err1, _ error, err2 error) {
+	err1 = &b.ValueError{} // want " \\(et:ret\\)$"
+	err2 = &b.PointerError{}
+
+	return
+}
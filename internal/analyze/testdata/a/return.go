@@ -27,7 +27,9 @@ func Return() {
 
 	_ = func() (any, error) { return func() (any, error) { return nil, (&b.ValueError{}) }() } // want " \\(et:ret\\)$"
 
-	_ = func() (error, error) { return &b.ValueError{}, nil }
+	_ = func() (error, error) { return &b.ValueError{}, nil } // want " \\(et:ret\\)$"
+
+	_ = func() (error, int) { return b.PointerError{}, 0 } // want " \\(et:ret\\+\\)$"
 
 	_ = func() error { return b.PointerError{} } // want " \\(et:ret\\+\\)$"
 }
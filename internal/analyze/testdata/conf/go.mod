@@ -0,0 +1,3 @@
+module conf
+
+go 1.24.0
@@ -0,0 +1,3 @@
+module evid
+
+go 1.24.0
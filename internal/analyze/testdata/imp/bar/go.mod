@@ -0,0 +1,3 @@
+module example.com/bar
+
+go 1.24.0
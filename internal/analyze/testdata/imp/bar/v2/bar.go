@@ -0,0 +1,40 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bar
+
+import "errors"
+
+type NotFoundError struct{}
+
+func (*NotFoundError) Error() string { return "not found" }
+
+var errInternal = errors.New("internal")
+
+func Fetch(ok bool) error {
+	if ok {
+		return errInternal
+	}
+
+	return &NotFoundError{}
+}
+
+func Wrap(err error) error { return &wrapError{err: err} }
+
+type wrapError struct{ err error }
+
+func (*wrapError) Error() string   { return "wrapped" }
+func (w *wrapError) Unwrap() error { return w.err }
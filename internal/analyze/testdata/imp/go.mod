@@ -0,0 +1,13 @@
+module imp
+
+go 1.24.0
+
+require (
+	example.com/bar v1.0.0
+	example.com/bar/v2 v2.0.0
+)
+
+replace (
+	example.com/bar => ./bar
+	example.com/bar/v2 => ./bar/v2
+)
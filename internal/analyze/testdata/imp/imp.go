@@ -0,0 +1,70 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imp
+
+import (
+	"errors"
+	"fmt"
+)
+
+type (
+	ValueError   struct{}
+	PointerError struct{}
+	WrapError    struct{ Err error }
+)
+
+func (ValueError) Error() string    { return "" }
+func (*PointerError) Error() string { return "" }
+func (*WrapError) Error() string    { return "" }
+func (w *WrapError) Unwrap() error  { return w.Err }
+
+func value() error { return ValueError{} }
+
+func wrapped() error { return fmt.Errorf("wrapped: %w", value()) }
+
+func wrapper() error { return &WrapError{Err: value()} }
+
+func Impossible(param error) {
+	err := value()
+
+	var perr *PointerError
+	_ = errors.As(err, &perr) // want "Target type \"\\*PointerError\" can never match, the inspected error only contains \"ValueError\". \\(et:imp\\)$"
+
+	var verr ValueError
+	_ = errors.As(err, &verr)
+
+	_ = errors.As(wrapped(), &perr) // want " \\(et:imp\\)$"
+	_ = errors.As(wrapped(), &verr)
+
+	_ = errors.As(wrapper(), &perr) // want " \\(et:imp\\)$"
+	_ = errors.As(wrapper(), &verr)
+
+	_ = errors.As(param, &perr)
+
+	_, _ = err.(*PointerError) // want "Type \"\\*PointerError\" can never match, the asserted error only contains \"ValueError\". \\(et:imp\\)$"
+	_, _ = err.(ValueError)
+
+	_, _ = wrapped().(*PointerError) // want " \\(et:imp\\)$"
+
+	switch err.(type) {
+	case *PointerError, *WrapError: // want " \\(et:imp\\)$" " \\(et:imp\\)$"
+	case ValueError:
+	}
+
+	var nilErr error
+	_ = errors.As(nilErr, &verr) // want "Target type \"ValueError\" can never match, the inspected error is always nil. \\(et:imp\\)$"
+}
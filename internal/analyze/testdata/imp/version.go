@@ -0,0 +1,40 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imp
+
+import (
+	"errors"
+
+	barv1 "example.com/bar"
+	"example.com/bar/v2"
+)
+
+func Version(err error) {
+	var v1 *barv1.NotFoundError
+	_ = errors.As(bar.Fetch(true), &v1) // want "Target type \"\\*example.com/bar.NotFoundError\" can never match, the inspected error is produced by \"example.com/bar/v2\", a different version of its module. \\(et:imp\\)$"
+
+	_ = errors.As(bar.Wrap(err), &v1) // want " \\(et:imp\\)$"
+
+	var v2 *bar.NotFoundError
+	_ = errors.As(bar.Fetch(true), &v2)
+
+	_, _ = bar.Wrap(err).(*barv1.NotFoundError)   // want "Type \"\\*example.com/bar.NotFoundError\" can never match, the asserted error is produced by \"example.com/bar/v2\", a different version of its module. \\(et:imp\\)$"
+	_, _ = bar.Fetch(true).(*barv1.NotFoundError) // want "Type \"\\*example.com/bar.NotFoundError\" can never match, the asserted error is produced by \"example.com/bar/v2\", a different version of its module. \\(et:imp\\)$"
+
+	_ = errors.As(barv1.Fetch(), &v1)
+	_ = errors.As(err, &v1)
+}
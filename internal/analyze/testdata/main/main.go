@@ -218,7 +218,7 @@ func iface() {
 	var pve interface {
 		fmt.Stringer
 		error
-	} = &BadValueError{Msg: "iface pointer to value"}
+	} = &BadValueError{Msg: "iface pointer to value"} // want " \\(et:flw\\)$"
 
 	_ = BadValueError{}
 
@@ -0,0 +1,3 @@
+module vote
+
+go 1.24.0
@@ -0,0 +1,27 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package vote
+
+import "vote/lib"
+
+func Vote() error {
+	return lib.VoteError{} // want "Error type \"vote/lib.VoteError\" should be returned as a pointer \\(\"&lib.VoteError{...}\"\\), not by value. Usage determined by module vote. Confidence: heuristic. \\(et:ret\\+\\)$"
+}
+
+func Other() error {
+	return lib.OtherError{} // want " \\(et:emb\\)$"
+}
@@ -52,12 +52,12 @@ func (p pass) processAliases() {
 		} else {
 			// If the original type is from another package, we rely on
 			// the facts exported by that package's analysis.
-			var errorType errortypes.ErrorType
-			if !p.ImportObjectFact(orig, &errorType) {
+			var determined errortypes.Determined
+			if !p.ImportObjectFact(orig, &determined) {
 				continue
 			}
 
-			switch errorType {
+			switch determined.ErrorType {
 			case errortypes.PointerType:
 				property = PointerAlias
 
@@ -66,7 +66,7 @@ func (p pass) processAliases() {
 			}
 		}
 
-		// We have either found the type in our property map, or imported an ErrorType fact
+		// We have either found the type in our property map, or imported a Determined fact
 		// so it has to be an alias to an error type.
 		p.AddTypeProperty(alias, property)
 	}
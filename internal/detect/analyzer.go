@@ -22,6 +22,7 @@ import (
 	"golang.org/x/tools/go/analysis"
 
 	"fillmore-labs.com/errortype/internal/errortypes"
+	"fillmore-labs.com/errortype/internal/flow"
 )
 
 // New creates a new instance of the detecttypes analyzer.
@@ -36,13 +37,28 @@ func New(opts ...Option) *analysis.Analyzer {
 		URL:              "https://pkg.go.dev/fillmore-labs.com/errortype/internal/detect",
 		Run:              o.run,
 		RunDespiteErrors: true,
-		FactTypes:        []analysis.Fact{(*errortypes.ErrorType)(nil)},
+		Requires:         []*analysis.Analyzer{flow.Analyzer},
+		FactTypes:        []analysis.Fact{(*errortypes.Determined)(nil)},
 		ResultType:       reflect.TypeFor[Result](),
 	}
 
+	a.Flags.Func("policy", "required usage of error types, like \"struct=pointer,nonstruct=value\"", o.setPolicy)
+	a.Flags.Func("policy-exceptions", "comma-separated packages or types exempt from the policy", o.setPolicyExceptions)
+	a.Flags.BoolVar(&o.requireDecl, "require-decl", o.requireDecl,
+		"report exported error types whose usage is not declared explicitly")
 	a.Flags.BoolVar(&o.debug, "debug", o.debug, "debug output")
-	a.Flags.Func("overrides", "read error type overrides from this file", o.readOverrides)
-	a.Flags.Func("heuristics", "list of heuristics used (default: \"usage,receivers\", \"off\" to disable)", o.setHeuristics)
+	a.Flags.BoolVar(&o.overridesLenient, "overrides-lenient", o.overridesLenient,
+		"ignore unknown keys and skip invalid entries in overrides files instead of failing")
+	a.Flags.Func("overrides", "read error type overrides from this file (repeatable, later files take precedence)", o.readOverrides)
+	a.Flags.Func("sentinels", "regular expression matching the names of sentinel errors (repeatable, default: \""+
+		defaultSentinelPattern.String()+"\")", o.setSentinelPatterns)
+	a.Flags.BoolVar(&o.sentinels.packageVars, "packagevars", o.sentinels.packageVars,
+		"treat all package-level variables of concrete error types as declarations")
+	a.Flags.Func("heuristics", "list of heuristics used (default: \"usage,constructors,receivers\", \"off\" to disable)", o.setHeuristics)
+	a.Flags.Func("precedence", "comma-separated ranks of evidence, from the strongest to the weakest (default: \""+
+		defaultPrecedence.String()+"\")", o.setPrecedence)
+	a.Flags.Func("defaults", "list of defaults for undetermined types (\"non-struct\", \"error-only\", default: \"off\")",
+		o.setTypeDefaults)
 
 	return a
 }
@@ -17,10 +17,16 @@
 package detect_test
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"go/ast"
 	"go/types"
+	"log"
 	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 
 	"golang.org/x/tools/go/analysis"
@@ -30,6 +36,7 @@ import (
 
 	. "fillmore-labs.com/errortype/internal/detect"
 	"fillmore-labs.com/errortype/internal/errortypes"
+	"fillmore-labs.com/errortype/internal/overrides"
 	"fillmore-labs.com/errortype/internal/typeutil"
 )
 
@@ -73,6 +80,358 @@ func TestExclusionsAnalyzer(t *testing.T) {
 	}
 }
 
+func TestDirectives(t *testing.T) {
+	t.Parallel()
+
+	if err := typeutil.HasGo(); err != nil {
+		t.Skipf("Go not available: %s", err)
+	}
+
+	dir := analysistest.TestData()
+
+	analysistest.Run(t, dir, New(), "test/dir")
+}
+
+func TestDocs(t *testing.T) {
+	t.Parallel()
+
+	if err := typeutil.HasGo(); err != nil {
+		t.Skipf("Go not available: %s", err)
+	}
+
+	dir := analysistest.TestData()
+
+	analysistest.Run(t, dir, New(WithHeuristics(HeuristicDocs)), "test/docs")
+}
+
+func TestTestFiles(t *testing.T) {
+	t.Parallel()
+
+	if err := typeutil.HasGo(); err != nil {
+		t.Skipf("Go not available: %s", err)
+	}
+
+	dir := analysistest.TestData()
+
+	analysistest.Run(t, dir, New(), "test/tst")
+}
+
+func TestPrecedence(t *testing.T) {
+	t.Parallel()
+
+	if err := typeutil.HasGo(); err != nil {
+		t.Skipf("Go not available: %s", err)
+	}
+
+	d := New()
+
+	dir := analysistest.TestData()
+
+	if err := d.Flags.Set("overrides", filepath.Join(dir, "precedence.yaml")); err != nil {
+		t.Fatalf("can't set overrides flag: %v", err)
+	}
+
+	analysistest.Run(t, dir, d, "test/prec")
+}
+
+func TestOverridePatterns(t *testing.T) {
+	t.Parallel()
+
+	if err := typeutil.HasGo(); err != nil {
+		t.Skipf("Go not available: %s", err)
+	}
+
+	d := New()
+
+	dir := analysistest.TestData()
+
+	if err := d.Flags.Set("overrides", filepath.Join(dir, "patterns.yaml")); err != nil {
+		t.Fatalf("can't set overrides flag: %v", err)
+	}
+
+	analysistest.Run(t, dir, d, "test/pat/...", "test/patx")
+}
+
+func TestScopes(t *testing.T) {
+	t.Parallel()
+
+	if err := typeutil.HasGo(); err != nil {
+		t.Skipf("Go not available: %s", err)
+	}
+
+	d := New()
+
+	dir := analysistest.TestData()
+
+	if err := d.Flags.Set("overrides", filepath.Join(dir, "scopes.yaml")); err != nil {
+		t.Fatalf("can't set overrides flag: %v", err)
+	}
+
+	testAnalyzer := &analysis.Analyzer{
+		Name: "testanalyzer",
+		Doc:  "consumes results from detect.Analyzer for testing",
+		Run: func(ap *analysis.Pass) (any, error) {
+			return run(ap, d)
+		},
+		Requires: []*analysis.Analyzer{inspect.Analyzer, d},
+	}
+
+	analysistest.Run(t, dir, testAnalyzer, "test/scope/...")
+}
+
+func TestPinnedOverrides(t *testing.T) {
+	t.Parallel()
+
+	if err := typeutil.HasGo(); err != nil {
+		t.Skipf("Go not available: %s", err)
+	}
+
+	pins := &Pins{}
+	d := New(WithPins(pins))
+
+	dir := analysistest.TestData()
+
+	if err := d.Flags.Set("overrides", filepath.Join(dir, "pins.yaml")); err != nil {
+		t.Fatalf("can't set overrides flag: %v", err)
+	}
+
+	analysistest.Run(t, dir, d, "example.com/pinlib")
+
+	want := []UnmatchedPin{
+		{Override: "example.com/pinlib@<v1.0.0.Legacy*", Versions: []string{"v1.4.0"}},
+		{Override: "example.com/pinlib@<v1.0.0/...", Versions: []string{"v1.4.0"}},
+		{Override: "example.com/pinlib@<v1.2.0.LegacyError", Versions: []string{"v1.4.0"}},
+	}
+	if got := pins.Unmatched(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Unmatched() = %v, want %v", got, want)
+	}
+}
+
+func TestIncludes(t *testing.T) {
+	t.Parallel()
+
+	if err := typeutil.HasGo(); err != nil {
+		t.Skipf("Go not available: %s", err)
+	}
+
+	d := New()
+
+	dir := analysistest.TestData()
+
+	if err := d.Flags.Set("overrides", filepath.Join(dir, "include.yaml")); err != nil {
+		t.Fatalf("can't set overrides flag: %v", err)
+	}
+
+	if err := d.Flags.Set("overrides", filepath.Join(dir, "includes", "cycle.yaml")); !errors.Is(err, overrides.ErrIncludeCycle) {
+		t.Errorf("expected include cycle error, got: %v", err)
+	}
+
+	analysistest.Run(t, dir, d, "test/incl")
+}
+
+// TestOverrideConflicts captures the log output, so it doesn't run in parallel.
+func TestOverrideConflicts(t *testing.T) { //nolint:paralleltest
+	if err := typeutil.HasGo(); err != nil {
+		t.Skipf("Go not available: %s", err)
+	}
+
+	var buf bytes.Buffer
+
+	w := log.Writer()
+	log.SetOutput(&buf)
+	t.Cleanup(func() { log.SetOutput(w) })
+
+	d := New()
+
+	dir := analysistest.TestData()
+
+	if err := d.Flags.Set("overrides", filepath.Join(dir, "include.yaml")); err != nil {
+		t.Fatalf("can't set overrides flag: %v", err)
+	}
+
+	analysistest.Run(t, dir, d, "test/incl")
+
+	// A pattern in an included file and an exact type name conflict for the types the pattern matches.
+	want := fmt.Sprintf("Conflicting overrides for test/incl.GlobError: Pointer at %s:7:5, Value at %s:9:5; using Value\n",
+		filepath.Join(dir, "includes", "org.yaml"), filepath.Join(dir, "include.yaml"))
+	if n := strings.Count(buf.String(), want); n != 1 {
+		t.Errorf("expected conflict %q logged once, got %d times in:\n%s", want, n, buf.String())
+	}
+
+	if strings.Contains(buf.String(), "test/incl.GlobOther") {
+		t.Errorf("unexpected conflict for GlobOther in:\n%s", buf.String())
+	}
+}
+
+func TestStrictOverrides(t *testing.T) {
+	t.Parallel()
+
+	if err := typeutil.HasGo(); err != nil {
+		t.Skipf("Go not available: %s", err)
+	}
+
+	dir := analysistest.TestData()
+
+	err := strictError(t, dir, "unknown.yaml")
+	if err == nil || !strings.Contains(err.Error(), "unknown.yaml:3:1: unknown field \"pointr\"") {
+		t.Errorf("expected unknown field error, got: %v", err)
+	}
+
+	err = strictError(t, dir, "invalid.yaml")
+	for _, want := range []error{overrides.ErrInvalidTypeName, overrides.ErrDuplicate, overrides.ErrConflict} {
+		if !errors.Is(err, want) {
+			t.Errorf("expected error %v, got: %v", want, err)
+		}
+	}
+
+	for _, flags := range [][2]string{
+		{"overrides-lenient", "overrides"},
+		{"overrides", "overrides-lenient"},
+	} {
+		t.Run(flags[0]+" "+flags[1], func(t *testing.T) {
+			t.Parallel()
+
+			d := New()
+
+			for _, name := range flags {
+				value := "true"
+				if name == "overrides" {
+					value = filepath.Join(dir, "strict", "invalid.yaml")
+				}
+
+				if err := d.Flags.Set(name, value); err != nil {
+					t.Fatalf("can't set %s flag: %v", name, err)
+				}
+			}
+
+			analysistest.Run(t, dir, d, "test/strict")
+		})
+	}
+}
+
+// strictError returns the error of analyzing test/strict with the given invalid overrides file.
+func strictError(t *testing.T, dir, file string) error {
+	t.Helper()
+
+	d := New()
+
+	if err := d.Flags.Set("overrides", filepath.Join(dir, "strict", file)); err != nil {
+		t.Fatalf("can't set overrides flag: %v", err)
+	}
+
+	for _, result := range analysistest.Run(ignoreErrors{}, dir, d, "test/strict") {
+		if result.Err != nil {
+			return result.Err
+		}
+	}
+
+	return nil
+}
+
+// ignoreErrors is an [analysistest.Testing] ignoring expected analysis errors.
+type ignoreErrors struct{}
+
+func (ignoreErrors) Errorf(string, ...any) {}
+
+func TestOverrideUsage(t *testing.T) {
+	t.Parallel()
+
+	if err := typeutil.HasGo(); err != nil {
+		t.Skipf("Go not available: %s", err)
+	}
+
+	usage := &OverrideUsage{}
+	d := New(WithOverrideUsage(usage))
+
+	dir := analysistest.TestData()
+
+	if err := d.Flags.Set("overrides", filepath.Join(dir, "unused.yaml")); err != nil {
+		t.Fatalf("can't set overrides flag: %v", err)
+	}
+
+	analysistest.Run(t, dir, d, "test/unused")
+
+	var got []string
+	for _, problem := range usage.Problems() {
+		got = append(got, fmt.Sprintf("%d: %s", problem.Override.Pos.Line, problem.Message))
+	}
+
+	want := []string{
+		"5: Pointer override for test/unused.RedundantError is redundant, autodetection already yields pointer",
+		"6: Pointer override for test/unused.Typo matches no type",
+		"9: Value override for test/unused.ImpossibleError is impossible, test/unused.ImpossibleError does not implement the error interface",
+		"10: Value override for test/unused.*Missing matches no type",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Problems() = %q, want %q", got, want)
+	}
+}
+
+func TestContradictions(t *testing.T) {
+	t.Parallel()
+
+	if err := typeutil.HasGo(); err != nil {
+		t.Skipf("Go not available: %s", err)
+	}
+
+	dir := analysistest.TestData()
+
+	analysistest.Run(t, dir, New(), "test/def")
+}
+
+func TestDeclarations(t *testing.T) {
+	t.Parallel()
+
+	if err := typeutil.HasGo(); err != nil {
+		t.Skipf("Go not available: %s", err)
+	}
+
+	dir := analysistest.TestData()
+
+	analysistest.RunWithSuggestedFixes(t, dir, New(WithRequireDecl(true), WithTypeDefaults(TypeDefaultNonStruct)), "test/decl")
+}
+
+func TestSentinels(t *testing.T) {
+	t.Parallel()
+
+	if err := typeutil.HasGo(); err != nil {
+		t.Skipf("Go not available: %s", err)
+	}
+
+	dir := analysistest.TestData()
+
+	d := New(WithSentinelPatterns(regexp.MustCompile(`^Err`), regexp.MustCompile(`^test/sent\.NotFound$`)), WithPackageVars(true))
+
+	analysistest.Run(t, dir, d, "test/sent")
+	// Each -sentinels flag adds a pattern.
+	f := New(WithPackageVars(true))
+	for _, expr := range []string{`^Err`, `^test/sent\.NotFound$`} {
+		if err := f.Flags.Set("sentinels", expr); err != nil {
+			t.Fatalf("can't set sentinels flag: %v", err)
+		}
+	}
+
+	analysistest.Run(t, dir, f, "test/sent")
+}
+
+func TestPolicy(t *testing.T) {
+	t.Parallel()
+
+	if err := typeutil.HasGo(); err != nil {
+		t.Skipf("Go not available: %s", err)
+	}
+
+	dir := analysistest.TestData()
+
+	d := New(WithPolicy(Policy{
+		Struct:     errortypes.PointerType,
+		NonStruct:  errortypes.ValueType,
+		Exceptions: []string{"test/pol.PolException", "test/polx"},
+	}))
+
+	analysistest.RunWithSuggestedFixes(t, dir, d, "test/pol", "test/polx")
+}
+
 var (
 	// ErrNoInspectorResult is returned when the ast inspector is missing.
 	ErrNoInspectorResult = errors.New("testanalyzer: inspector result missing")
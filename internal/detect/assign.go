@@ -61,7 +61,7 @@ func (v assignVisitor) Visit(node ast.Node) ast.Visitor {
 	case *ast.CallExpr:
 		if tv, ok := v.TypesInfo.Types[n.Fun]; ok && tv.IsType() {
 			// Type cast, e.g., type MyError string; MyError("error").
-			v.handleCast(tv.Type)
+			v.handleCast(tv.Type, n.Pos())
 
 			return nil
 		}
@@ -73,12 +73,7 @@ func (v assignVisitor) Visit(node ast.Node) ast.Visitor {
 	case *ast.FuncLit:
 		// A function literal in an assignment context defines a new function.
 		// We need to inspect its body for how it returns error types.
-		u := usageVisitor{
-			pass:       v.pass,
-			lastResult: typeutil.HasErrorResult(v.TypesInfo, n.Type.Results),
-		}
-
-		ast.Walk(u, n.Body)
+		ast.Walk(v.newUsageVisitor(n.Type), n.Body)
 
 		return nil // Handled, stop descending.
 
@@ -112,11 +107,11 @@ func (p pass) handleTypeAssert(n *ast.TypeAssertExpr) {
 		prop = PointerAssert
 	}
 
-	p.addTypePropertyInCurrentPackage(tn, prop)
+	p.addTypePropertyInCurrentPackage(tn, prop, n.Pos())
 }
 
 // handleCast processes a type conversion, e.g., T(v).
-func (p pass) handleCast(typ types.Type) {
+func (p pass) handleCast(typ types.Type, pos token.Pos) {
 	// We can only analyze named types.
 	tn, isPtr, ok := typeutil.TypeNameOf(typ)
 	if !ok {
@@ -128,7 +123,7 @@ func (p pass) handleCast(typ types.Type) {
 		prop = PointerCast
 	}
 
-	p.addTypePropertyInCurrentPackage(tn, prop)
+	p.addTypePropertyInCurrentPackage(tn, prop, pos)
 }
 
 // handleCompositeLit processes a composite literal, e.g., T{} or &T{}.
@@ -160,5 +155,5 @@ func (p pass) handleCompositeLit(n *ast.CompositeLit, isAddrOf bool) {
 		property = PointerLiteral
 	}
 
-	p.addTypePropertyInCurrentPackage(tn, property)
+	p.addTypePropertyInCurrentPackage(tn, property, n.Pos())
 }
@@ -0,0 +1,135 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package detect
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"fillmore-labs.com/errortype/internal/typeutil"
+)
+
+// processConstructors records error types created by constructor functions like
+// `func NewParseError(...) *ParseError` or `func newTimeout() error { return &timeoutErr{} }`.
+//
+// The concrete error result types of constructors are recorded, and for results of an
+// error interface type, the types of the returned values.
+func (p pass) processConstructors() {
+	for f := range p.AllFuncDecls {
+		if f.Recv != nil || f.Body == nil || !isConstructorName(f.Name.Name) {
+			continue
+		}
+
+		fun, ok := p.TypesInfo.Defs[f.Name].(*types.Func)
+		if !ok { // should not happen
+			p.LogErrorf(f.Name, "Not a types.Func: %s", f.Name.Name)
+
+			continue
+		}
+
+		p := p.inFile(f.Pos())
+
+		results := fun.Signature().Results()
+
+		var returned []int // Indices of error interface results.
+
+		for _, result := range typeutil.ErrorResults(p.TypesInfo, f.Type.Results) {
+			typ := results.At(result.Index).Type()
+			if types.IsInterface(typ) {
+				returned = append(returned, result.Index)
+
+				continue
+			}
+
+			p.recordConstructor(typ, f.Name.Pos())
+		}
+
+		if len(returned) > 0 {
+			p.constructorReturns(f.Body, results.Len(), returned)
+		}
+	}
+}
+
+// constructorReturns records the types of values returned for the error results
+// with the given indices in the body of a constructor.
+func (p pass) constructorReturns(body *ast.BlockStmt, numResults int, indices []int) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false // Returns of function literals are not returns of the constructor.
+
+		case *ast.ReturnStmt:
+			if len(n.Results) != numResults {
+				return false // Naked return or multi-value function call.
+			}
+
+			for _, i := range indices {
+				if tv, ok := p.TypesInfo.Types[n.Results[i]]; ok && !tv.IsNil() {
+					p.recordConstructor(tv.Type, n.Results[i].Pos())
+				}
+			}
+
+			return false
+		}
+
+		return true
+	})
+}
+
+// recordConstructor records the type created by a constructor.
+func (p pass) recordConstructor(typ types.Type, pos token.Pos) {
+	if types.IsInterface(typ) {
+		return
+	}
+
+	tn, isPtr, ok := typeutil.TypeNameOf(typ)
+	if !ok {
+		return // Not a named type.
+	}
+
+	property := ValueConstructor
+	if isPtr {
+		property = PointerConstructor
+	}
+
+	p.addTypePropertyInCurrentPackage(tn, property, pos)
+}
+
+// isConstructorName reports whether name is the name of a constructor function,
+// like "New", "NewParseError" or "newTimeout", but not "Newline".
+func isConstructorName(name string) bool {
+	rest, ok := strings.CutPrefix(name, "New")
+	if !ok {
+		rest, ok = strings.CutPrefix(name, "new")
+	}
+
+	if !ok {
+		return false
+	}
+
+	if rest == "" {
+		return true
+	}
+
+	r, _ := utf8.DecodeRuneInString(rest)
+
+	return unicode.IsUpper(r) || unicode.IsDigit(r) || r == '_'
+}
@@ -0,0 +1,145 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package detect
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"fillmore-labs.com/errortype/internal/errortypes"
+)
+
+// maxSites is the maximum number of sites listed for each kind of contradictory evidence.
+const maxSites = 3
+
+// sites records the positions where properties of types were found.
+type sites map[*types.TypeName]map[ErrorProperty][]token.Pos
+
+// add records a position where a property of a type was found.
+func (s sites) add(tn *types.TypeName, property ErrorProperty, pos token.Pos) {
+	if !pos.IsValid() {
+		return
+	}
+
+	m, ok := s[tn]
+	if !ok {
+		m = make(map[ErrorProperty][]token.Pos)
+		s[tn] = m
+	}
+
+	m[property] = append(m[property], pos)
+}
+
+// processContradictions reports error types of the current package with contradictory evidence
+// in the ranks that were skipped before their usage was decided.
+//
+// Suppressed types and types with a pointer receiver Error() method are decided before any rank.
+func (p pass) processContradictions() {
+	for tn, property := range p.AllSorted {
+		if tn.Pkg() != p.Pkg || tn.IsAlias() || property&PointerReceiver != 0 {
+			continue
+		}
+
+		if p.determinedType(property) == errortypes.SuppressType {
+			continue
+		}
+
+		var conflicts []string
+
+		decider := p.decider(property)
+		for _, r := range p.ladder.precedence.ranks {
+			pointer, value := property&r.pair[0], property&r.pair[1]
+			if pointer == 0 || value == 0 {
+				if pointer != 0 || value != 0 {
+					break // This rank decided.
+				}
+
+				continue
+			}
+
+			conflicts = append(conflicts, fmt.Sprintf("%s: pointer%s, value%s",
+				r, p.formatSites(tn, pointer), p.formatSites(tn, value)))
+		}
+
+		if len(conflicts) == 0 {
+			continue
+		}
+
+		p.ReportRangef(typeNameIdent{tn}, "Contradictory evidence for error type %q: %s. %s (et:def)",
+			tn.Name(), strings.Join(conflicts, "; "), decider)
+	}
+}
+
+// decider describes how the usage of a type with the given properties was finally decided.
+func (p pass) decider(property ErrorProperty) string {
+	determined := p.ladder.decide(property)
+	if determined.ErrorType == errortypes.Undecided {
+		return "Usage remains undetermined."
+	}
+
+	kind := strings.ToLower(determined.ErrorType.String())
+
+	for _, r := range p.ladder.precedence.ranks {
+		pointer, value := property&r.pair[0] != 0, property&r.pair[1] != 0
+		if pointer != value {
+			return fmt.Sprintf("Usage decided as %s by %q.", kind, r.String())
+		}
+	}
+
+	return fmt.Sprintf("Usage decided as %s by the type definition.", kind)
+}
+
+// formatSites lists the sites where properties of a type were found.
+func (p pass) formatSites(tn *types.TypeName, property ErrorProperty) string {
+	var positions []token.Pos
+
+	for prop, pos := range p.sites[tn] {
+		if prop&property != 0 {
+			positions = append(positions, pos...)
+		}
+	}
+
+	if len(positions) == 0 {
+		return ""
+	}
+
+	slices.Sort(positions)
+	positions = slices.Compact(positions)
+
+	list := make([]string, 0, min(len(positions), maxSites))
+	for _, pos := range positions[:min(len(positions), maxSites)] {
+		position := p.Fset.Position(pos)
+		list = append(list, fmt.Sprintf("%s:%d", filepath.Base(position.Filename), position.Line))
+	}
+
+	if more := len(positions) - maxSites; more > 0 {
+		list = append(list, fmt.Sprintf("%d more", more))
+	}
+
+	return " at " + strings.Join(list, ", ")
+}
+
+// typeNameIdent is an [analysis.Range] for the name of a type declaration.
+type typeNameIdent struct{ tn *types.TypeName }
+
+func (t typeNameIdent) Pos() token.Pos { return t.tn.Pos() }
+
+func (t typeNameIdent) End() token.Pos { return t.tn.Pos() + token.Pos(len(t.tn.Name())) }
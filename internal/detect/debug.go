@@ -45,14 +45,14 @@ func (p pass) logResults() {
 	qf := types.RelativeTo(p.Pkg)
 
 	for tn, errortype := range p.AllSorted {
-		determinedType := errortype.DeterminedType()
+		determined := p.ladder.decide(errortype)
 
 		var extra string
-		if mismatch := determinedTypeCheck(tn, determinedType); mismatch != "" {
+		if mismatch := determinedTypeCheck(tn, determined.ErrorType); mismatch != "" {
 			extra = " !!! " + mismatch + " !!!"
 		}
 
-		log.Printf("%s %s: %s (%s)%s", p.Pkg.Path(), types.TypeString(tn.Type(), qf), determinedType, errortype, extra)
+		log.Printf("%s %s: %s (%s)%s", p.Pkg.Path(), types.TypeString(tn.Type(), qf), determined, errortype, extra)
 	}
 }
 
@@ -0,0 +1,171 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package detect
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+
+	"fillmore-labs.com/errortype/internal/errortypes"
+)
+
+// declarationMask is a bitmask to identify properties explicitly declaring the usage of an error type.
+const declarationMask = PointerReceiver | OverrideMask |
+	PointerVar | ValueVar | SuppressDirective | PointerDirective | ValueDirective
+
+// processDeclarations reports exported error types of the current package whose usage
+// is not declared explicitly by a pointer receiver, a variable declaration, an override,
+// or a directive.
+func (p pass) processDeclarations() {
+	for tn, property := range p.AllSorted {
+		if tn.Pkg() != p.Pkg || tn.IsAlias() || !tn.Exported() || p.inFile(tn.Pos()).inTest {
+			continue
+		}
+
+		if p.determinedType(property&declarationMask) != errortypes.Undecided {
+			continue
+		}
+
+		diagnostic := analysis.Diagnostic{
+			Pos:     tn.Pos(),
+			End:     typeNameIdent{tn}.End(),
+			Message: fmt.Sprintf("Exported error type %q does not declare its usage explicitly.", tn.Name()),
+		}
+
+		if fix, decl, ok := p.declarationFix(tn, p.determinedType(property)); ok {
+			diagnostic.Message += " Add `" + decl + "`."
+			diagnostic.SuggestedFixes = []analysis.SuggestedFix{fix}
+		} else {
+			diagnostic.Message += " Add a `var _ error` declaration or a directive."
+		}
+
+		diagnostic.Message += " (et:decl)"
+
+		p.Report(diagnostic)
+	}
+}
+
+// declarationFix returns a suggested fix inserting a variable declaration of the given usage
+// after the declaration of a type, together with the inserted declaration.
+func (p pass) declarationFix(tn *types.TypeName, errorType errortypes.ErrorType) (analysis.SuggestedFix, string, bool) {
+	decl, ok := declaration(tn, errorType)
+	if !ok {
+		return analysis.SuggestedFix{}, "", false
+	}
+
+	gen, ok := p.typeDecl(tn)
+	if !ok {
+		return analysis.SuggestedFix{}, "", false
+	}
+
+	// Insert after the line ending the declaration, so trailing comments stay in place.
+	pos, text := gen.End(), "\n\n"+decl
+	if f := p.Fset.File(pos); f != nil {
+		if line := f.Line(pos); line < f.LineCount() {
+			pos, text = f.LineStart(line+1), "\n"+decl+"\n"
+		}
+	}
+
+	fix := analysis.SuggestedFix{
+		Message:   "Add `" + decl + "`",
+		TextEdits: []analysis.TextEdit{{Pos: pos, End: pos, NewText: []byte(text)}},
+	}
+
+	return fix, decl, true
+}
+
+// declaration returns a variable declaration of the given usage for a type,
+// like `var _ error = (*T)(nil)` or `var _ error = T{}`.
+func declaration(tn *types.TypeName, errorType errortypes.ErrorType) (string, bool) {
+	value, ok := ErrorAssertion(tn, errorType, nil)
+	if !ok {
+		return "", false
+	}
+
+	return "var _ error = " + value, true
+}
+
+// ErrorAssertion returns the value of an error assertion declaring the given usage for a type,
+// like `(*pkg.T)(nil)` or `pkg.T{}`. The package of the type is named by qualifier, when not nil.
+func ErrorAssertion(tn *types.TypeName, errorType errortypes.ErrorType, qualifier types.Qualifier) (string, bool) {
+	if named, ok := tn.Type().(*types.Named); ok && named.TypeParams().Len() > 0 {
+		return "", false // We can't instantiate generic types.
+	}
+
+	name := tn.Name()
+	if qualifier != nil && tn.Pkg() != nil {
+		if q := qualifier(tn.Pkg()); q != "" {
+			name = q + "." + name
+		}
+	}
+
+	switch errorType {
+	case errortypes.PointerType:
+		return "(*" + name + ")(nil)", true
+
+	case errortypes.ValueType:
+		return zeroValue(name, tn.Type().Underlying())
+	}
+
+	return "", false
+}
+
+// zeroValue returns an expression for the zero value of a named type with the given underlying type.
+func zeroValue(name string, underlying types.Type) (string, bool) {
+	switch u := underlying.(type) {
+	case *types.Struct, *types.Array, *types.Slice, *types.Map:
+		return name + "{}", true
+
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsString != 0:
+			return name + `("")`, true
+
+		case u.Info()&types.IsNumeric != 0:
+			return name + "(0)", true
+
+		case u.Info()&types.IsBoolean != 0:
+			return name + "(false)", true
+		}
+
+	case *types.Pointer, *types.Chan, *types.Signature:
+		return name + "(nil)", true
+	}
+
+	return "", false
+}
+
+// typeDecl finds the declaration of a type in the current package.
+func (p pass) typeDecl(tn *types.TypeName) (*ast.GenDecl, bool) {
+	for gen := range allDecls[*ast.GenDecl](p.Files) {
+		if gen.Tok != token.TYPE || gen.Pos() > tn.Pos() || gen.End() < tn.Pos() {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			if typespec, ok := spec.(*ast.TypeSpec); ok && p.TypesInfo.Defs[typespec.Name] == tn {
+				return gen, true
+			}
+		}
+	}
+
+	return nil, false
+}
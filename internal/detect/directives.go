@@ -0,0 +1,162 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package detect
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"fillmore-labs.com/errortype/internal/typeutil"
+)
+
+// directivePrefix starts a directive declaring the kind of an error type, like `//errortype:pointer`.
+const directivePrefix = "//errortype:"
+
+// directives maps directive names to the properties they set.
+var directives = map[string]ErrorProperty{
+	"pointer":  PointerDirective,
+	"value":    ValueDirective,
+	"suppress": SuppressDirective,
+}
+
+// processDirectives records directives in the doc comments of error type declarations,
+// like
+//
+//	//errortype:pointer
+//	type MyError struct{ error }
+//
+// Unknown, conflicting, invalid or misplaced directives are reported.
+func (p pass) processDirectives() {
+	placed := make(map[*ast.Comment]struct{})
+
+	for g := range allDecls[*ast.GenDecl](p.Files) {
+		if g.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range g.Specs {
+			typespec, ok := spec.(*ast.TypeSpec)
+			if !ok {
+				continue
+			}
+
+			groups := []*ast.CommentGroup{typespec.Doc, typespec.Comment}
+			if !g.Lparen.IsValid() { // type T ...
+				groups = append(groups, g.Doc)
+			}
+
+			p.typeDirectives(typespec, groups, placed)
+		}
+	}
+
+	for _, f := range p.Files {
+		for _, group := range f.Comments {
+			for _, c := range group.List {
+				if _, ok := placed[c]; ok || !strings.HasPrefix(c.Text, directivePrefix) {
+					continue
+				}
+
+				p.ReportRangef(c, "Directive %q must be placed on an error type declaration. (et:dir)", c.Text)
+			}
+		}
+	}
+}
+
+// typeDirectives records the directives in the comment groups of a type declaration.
+func (p pass) typeDirectives(typespec *ast.TypeSpec, groups []*ast.CommentGroup, placed map[*ast.Comment]struct{}) {
+	tn, ok := p.TypesInfo.Defs[typespec.Name].(*types.TypeName)
+	if !ok || tn.IsAlias() {
+		return // Aliases inherit the kind of the aliased type.
+	}
+
+	if _, ok := p.GetTypeProperty(tn); !ok {
+		return // Not an error type.
+	}
+
+	var (
+		first    *ast.Comment
+		property ErrorProperty
+	)
+
+	for _, group := range groups {
+		if group == nil {
+			continue
+		}
+
+		for _, c := range group.List {
+			name, ok := strings.CutPrefix(c.Text, directivePrefix)
+			if !ok {
+				continue
+			}
+
+			placed[c] = struct{}{}
+
+			name, _, _ = strings.Cut(name, " ") // Allow trailing comments.
+
+			prop, ok := directives[name]
+			switch {
+			case !ok:
+				p.ReportRangef(c, "Unknown directive %q, expected one of %q, %q or %q. (et:dir)",
+					c.Text, directivePrefix+"pointer", directivePrefix+"value", directivePrefix+"suppress")
+
+			case first != nil && prop != property:
+				p.ReportRangef(c, "Directive %q conflicts with %q for type %q. (et:dir)", c.Text, first.Text, tn.Name())
+
+				return
+
+			case !p.validDirective(tn, prop):
+				p.ReportRangef(c, "Directive %q is invalid, %q does not implement the error interface. (et:dir)",
+					c.Text, directiveType(tn, prop))
+
+				return
+
+			default:
+				first, property = c, prop
+			}
+		}
+	}
+
+	if first != nil {
+		p.AddTypeProperty(tn, property)
+		p.sites.add(tn, property, first.Pos())
+	}
+}
+
+// validDirective reports whether the type declared by the directive implements the error interface.
+func (p pass) validDirective(tn *types.TypeName, property ErrorProperty) bool {
+	switch property {
+	case PointerDirective:
+		return typeutil.HasErrorMethod(types.NewPointer(tn.Type()))
+
+	case ValueDirective:
+		return typeutil.HasErrorMethod(tn.Type())
+
+	default:
+		return true
+	}
+}
+
+// directiveType returns the name of the type declared by a pointer or value directive.
+func directiveType(tn *types.TypeName, property ErrorProperty) string {
+	if property == PointerDirective {
+		return "*" + tn.Name()
+	}
+
+	return tn.Name()
+}
@@ -24,12 +24,19 @@
 //  2. User-defined overrides from an external file, which can explicitly set an
 //     error as a pointer type, a value type, or suppress analysis.
 //  3. Variable declarations, such as compile-time assertions (`var _ error = T{}`)
-//     or sentinel errors (`var ErrSomething = &T{}`).
-//  4. An inspection of usage in function bodies, such as return statements, type
-//     assertions, composite literals, and type casts.
-//  5. As a final heuristic, a check for consistent receiver types (all pointer
+//     or sentinel errors (`var ErrSomething = &T{}`, matched by configurable name patterns
+//     or declared in a block whose documentation starts with "sentinel"),
+//     and directives on the type declaration (`//errortype:pointer`). Optionally, all other
+//     package-level variables of concrete error types.
+//  4. An inspection of usage in function bodies, such as constructor functions, return
+//     statements, type assertions, composite literals, and type casts.
+//  5. Optionally, references to error types in doc comments, like "returns a *PathError".
+//  6. As a final heuristic, a check for consistent receiver types (all pointer
 //     or all value) across all methods of the error type.
 //
+// The order of the categories of evidence is configurable with [WithPrecedence], and
+// [WithTypeDefaults] decides types without decisive evidence by their definition.
+//
 // The determindes error types are passed as facts across packages and as a result
 // for the errortype analyzer to use.
 package detect
@@ -0,0 +1,110 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package detect
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+	"unicode"
+)
+
+// docIntroducers are words preceding a reference to an error type value in doc comments, like
+// "returns a SyntaxError" or "err will be of type SyntaxError".
+var docIntroducers = map[string]struct{}{
+	"a": {}, "an": {}, "type": {}, "return": {}, "returns": {}, "returned": {},
+}
+
+// processDocs records references to error types of the current package in the doc comments
+// of functions and types, like "returns a *PathError" or "err will be of type SyntaxError".
+//
+// References with a "*" are pointer references, others are only recognized after one of the
+// [docIntroducers], since prose often mentions type names.
+func (p pass) processDocs() {
+	for _, f := range p.Files {
+		p := p.inFile(f.Pos())
+
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				p.docReferences(decl.Doc, nil)
+
+			case *ast.GenDecl:
+				if decl.Tok != token.TYPE {
+					continue
+				}
+
+				for _, spec := range decl.Specs {
+					typespec, ok := spec.(*ast.TypeSpec)
+					if !ok {
+						continue
+					}
+
+					tn, _ := p.TypesInfo.Defs[typespec.Name].(*types.TypeName)
+
+					doc := typespec.Doc
+					if doc == nil && !decl.Lparen.IsValid() {
+						doc = decl.Doc
+					}
+
+					p.docReferences(doc, tn)
+				}
+			}
+		}
+	}
+}
+
+// docReferences records references to error types in a doc comment. Value references
+// to self, the documented type, are ignored, since type docs usually start with its name.
+func (p pass) docReferences(doc *ast.CommentGroup, self *types.TypeName) {
+	if doc == nil {
+		return
+	}
+
+	var previous string
+
+	for word := range strings.FieldsSeq(doc.Text()) {
+		name, isPtr := docTypeName(word)
+		_, introduced := docIntroducers[strings.ToLower(previous)]
+		previous = word
+
+		if (!introduced && !isPtr) || !token.IsIdentifier(name) {
+			continue
+		}
+
+		tn, ok := p.Pkg.Scope().Lookup(name).(*types.TypeName)
+		if !ok || (tn == self && !isPtr) {
+			continue
+		}
+
+		property := ValueDoc
+		if isPtr {
+			property = PointerDoc
+		}
+
+		p.addTypePropertyInCurrentPackage(tn, property, doc.Pos())
+	}
+}
+
+// docTypeName extracts a possible type name from a word in a doc comment, like "*PathError" from
+// "[*PathError]," and reports whether it is a pointer.
+func docTypeName(word string) (name string, isPtr bool) {
+	word = strings.TrimFunc(word, func(r rune) bool { return unicode.IsPunct(r) && r != '*' && r != '_' })
+
+	return strings.CutPrefix(word, "*")
+}
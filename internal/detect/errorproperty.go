@@ -26,7 +26,7 @@ import (
 // ErrorProperty is a bitmask representing properties of an error type's definition
 // and usage. These properties are collected to determine whether a type should be
 // consistently used as a pointer (*T) or a value (T).
-type ErrorProperty int32
+type ErrorProperty int64
 
 // Properties are grouped by the heuristic that discovers them.
 const (
@@ -45,6 +45,15 @@ const (
 	// ValueOverride is set if the type is explicitly marked as a value type in overrides.
 	ValueOverride
 
+	// --- Properties from directives on the type declaration (e.g., `//errortype:pointer`) ---.
+
+	// SuppressDirective is set if usage checks for this type are suppressed by `//errortype:suppress`.
+	SuppressDirective
+	// PointerDirective is set if the type is marked as a pointer type by `//errortype:pointer`.
+	PointerDirective
+	// ValueDirective is set if the type is marked as a value type by `//errortype:value`.
+	ValueDirective
+
 	// --- Properties from variable declarations (e.g., `var ErrSomething = ...`) ---.
 
 	// PointerVar is set for pointer usage, e.g., `var _ error = &T{}` or `var Err = &T{}`.
@@ -52,6 +61,13 @@ const (
 	// ValueVar is set for value usage, e.g., `var _ error = T{}` or `var Err = T{}`.
 	ValueVar
 
+	// --- Properties from other package-level variables (e.g., `var NotFound = ...`) ---.
+
+	// PointerPackageVar is set for pointer usage, e.g., `var NotFound = &T{}`.
+	PointerPackageVar
+	// ValuePackageVar is set for value usage, e.g., `var NotFound = T{}`.
+	ValuePackageVar
+
 	// --- Properties from type aliases (e.g., `type T = V`) ---.
 
 	// PointerAlias is set for an alias to an imported pointer-type error.
@@ -59,6 +75,13 @@ const (
 	// ValueAlias is set for an alias to an imported value-type error.
 	ValueAlias
 
+	// --- Properties from constructor functions (e.g., `func NewT() *T`) ---.
+
+	// PointerConstructor is set for pointer usage, e.g., `func NewT() error { return &T{} }`.
+	PointerConstructor
+	// ValueConstructor is set for value usage, e.g., `func NewT() T`.
+	ValueConstructor
+
 	// --- Properties from usage in return statements ---.
 
 	// PointerReturn is set for pointer usage, e.g., `return &T{}`.
@@ -94,6 +117,13 @@ const (
 	// ValueCast is set for value usage, e.g., `(T)(v)`.
 	ValueCast
 
+	// --- Properties from doc comments (e.g., "returns a *T") ---.
+
+	// PointerDoc is set for pointer references in doc comments, e.g., "returns a *T".
+	PointerDoc
+	// ValueDoc is set for value references in doc comments, e.g., "err will be of type T".
+	ValueDoc
+
 	// --- Properties from other method receivers ---.
 
 	// PointerReceivers is set if all methods on the type have pointer receivers (a weak indicator).
@@ -101,6 +131,20 @@ const (
 	// ValueReceivers is set if all methods on the type have value receivers (a weak indicator).
 	ValueReceivers
 
+	// --- Properties from usage in _test.go files ---.
+
+	// PointerTest is set for pointer usage in test files, which is only used without other evidence.
+	PointerTest
+	// ValueTest is set for value usage in test files, which is only used without other evidence.
+	ValueTest
+
+	// --- Properties from a module-wide usage vote ---.
+
+	// PointerVote is set if the type is consistently used as a pointer in the analyzed module.
+	PointerVote
+	// ValueVote is set if the type is consistently used as a value in the analyzed module.
+	ValueVote
+
 	// --- Properties from type definition ---.
 
 	// PointerDef is set for defined pointer types, e.g., `type T *S`. Such types are used like values.
@@ -109,6 +153,9 @@ const (
 	// NonStruct is set if the defined type is not a structure or pointer.
 	NonStruct
 
+	// ErrorOnly is set if the only method of the defined type is Error() with a value receiver.
+	ErrorOnly
+
 	// --- Others ---.
 
 	// None. We have no idea.
@@ -116,31 +163,48 @@ const (
 
 	// OverrideMask is a bitmask to identify any override property.
 	OverrideMask = PointerOverride | ValueOverride | SuppressOverride
+
+	// VoteMask is a bitmask to identify any vote property.
+	VoteMask = PointerVote | ValueVote
 )
 
 var errorProperties = map[ErrorProperty]string{
-	PointerReceiver:  "PointerReceiver",
-	SuppressOverride: "SuppressOverride",
-	PointerOverride:  "PointerOverride",
-	ValueOverride:    "ValueOverride",
-	PointerVar:       "PointerVar",
-	ValueVar:         "ValueVar",
-	PointerAlias:     "PointerAlias",
-	ValueAlias:       "ValueAlias",
-	PointerReturn:    "PointerReturn",
-	ValueReturn:      "ValueReturn",
-	PointerAssert:    "PointerAssert",
-	ValueAssert:      "ValueAssert",
-	PointerTarget:    "PointerTarget",
-	ValueTarget:      "ValueTarget",
-	PointerLiteral:   "PointerLiteral",
-	ValueLiteral:     "ValueLiteral",
-	PointerCast:      "PointerCast",
-	ValueCast:        "ValueCast",
-	PointerReceivers: "PointerReceivers",
-	ValueReceivers:   "ValueReceivers",
-	PointerDef:       "PointerDef",
-	NonStruct:        "NonStruct",
+	PointerReceiver:    "PointerReceiver",
+	SuppressOverride:   "SuppressOverride",
+	PointerOverride:    "PointerOverride",
+	ValueOverride:      "ValueOverride",
+	SuppressDirective:  "SuppressDirective",
+	PointerDirective:   "PointerDirective",
+	ValueDirective:     "ValueDirective",
+	PointerVar:         "PointerVar",
+	ValueVar:           "ValueVar",
+	PointerPackageVar:  "PointerPackageVar",
+	ValuePackageVar:    "ValuePackageVar",
+	PointerAlias:       "PointerAlias",
+	ValueAlias:         "ValueAlias",
+	PointerConstructor: "PointerConstructor",
+	ValueConstructor:   "ValueConstructor",
+	PointerReturn:      "PointerReturn",
+	ValueReturn:        "ValueReturn",
+	PointerAssert:      "PointerAssert",
+	ValueAssert:        "ValueAssert",
+	PointerTarget:      "PointerTarget",
+	ValueTarget:        "ValueTarget",
+	PointerLiteral:     "PointerLiteral",
+	ValueLiteral:       "ValueLiteral",
+	PointerCast:        "PointerCast",
+	ValueCast:          "ValueCast",
+	PointerDoc:         "PointerDoc",
+	ValueDoc:           "ValueDoc",
+	PointerReceivers:   "PointerReceivers",
+	ValueReceivers:     "ValueReceivers",
+	PointerTest:        "PointerTest",
+	ValueTest:          "ValueTest",
+	PointerVote:        "PointerVote",
+	ValueVote:          "ValueVote",
+	PointerDef:         "PointerDef",
+	NonStruct:          "NonStruct",
+	ErrorOnly:          "ErrorOnly",
 }
 
 // String returns the string representation of a TypeProperty.
@@ -167,55 +231,24 @@ func (e ErrorProperty) String() string {
 	return strings.Join(parts, ", ")
 }
 
-// propertyPairs defines the categories of evidence used to determine if an error
-// type is a pointer or value type. The pairs are ordered by precedence, from
-// the strongest evidence to the weakest. The first category with a non-contradictory
-// signal determines the type.
-var propertyPairs = [...][2]ErrorProperty{
-	{PointerOverride, ValueOverride},   // Strongest: Explicit user override.
-	{PointerVar, ValueVar},             // Sentinel errors or `var _ error` assertions.
-	{PointerAlias, ValueAlias},         // Aliases of imported error types.
-	{PointerReturn, ValueReturn},       // Usage in `return` statements.
-	{PointerAssert, ValueAssert},       // Usage in type assertions.
-	{PointerTarget, ValueTarget},       // Usage in errors.As-like functions.
-	{PointerLiteral, ValueLiteral},     // Usage as a composite literal.
-	{PointerCast, ValueCast},           // Usage in type casts.
-	{PointerReceivers, ValueReceivers}, // Weakest: consistency of other method receivers.
-}
+// testProperty returns the test usage property corresponding to a pointer or value property.
+func (e ErrorProperty) testProperty() ErrorProperty {
+	for _, c := range categories {
+		switch {
+		case e&c.pair[0] != 0:
+			return PointerTest
 
-// DeterminedType checks if the collected properties unambiguously determine
-// whether the type should be a pointer or a value error type.
-// It returns true for `ok` if the pointer-ness is determined.
-//
-// Contradictory properties (e.g., both PointerVar and ValueVar being set)
-// for a given category are ignored, and the decision moves to the next category.
-func (e ErrorProperty) DeterminedType() errortypes.ErrorType {
-	if e&SuppressOverride != 0 { // Suppression override has the highest precedence.
-		return errortypes.SuppressType
-	}
-
-	if e&PointerReceiver != 0 { // Errors with pointer receivers can only be used in only one way.
-		return errortypes.PointerType
-	}
-
-	for _, pair := range propertyPairs {
-		pointerProp, valueProp := pair[0], pair[1]
-		switch e & (pointerProp | valueProp) { // Check for a non-contradictory usage within this category.
-		case pointerProp:
-			return errortypes.PointerType
-
-		case valueProp:
-			return errortypes.ValueType
+		case e&c.pair[1] != 0:
+			return ValueTest
 		}
 	}
 
-	// A special case for defined pointer types like `type T *S`.
-	// Although the underlying type is a pointer, `T` itself is used as a value
-	// (e.g., you return `T`, not `*T`), so we treat it as a value type.
-	if e&PointerDef != 0 {
-		return errortypes.ValueType
-	}
+	return e
+}
 
-	// No unambiguous usage was found.
-	return errortypes.Undecided
+// DeterminedType checks if the collected properties unambiguously determine
+// whether the type should be a pointer or a value error type, using the default
+// precedence of evidence.
+func (e ErrorProperty) DeterminedType() errortypes.ErrorType {
+	return defaultLadder.determinedType(e)
 }
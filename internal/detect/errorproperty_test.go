@@ -23,18 +23,87 @@ import (
 	. "fillmore-labs.com/errortype/internal/detect"
 )
 
+// declared lists all declared properties in bit order.
+var declared = [...]struct {
+	property ErrorProperty
+	name     string
+}{
+	{PointerReceiver, "PointerReceiver"},
+	{SuppressOverride, "SuppressOverride"},
+	{PointerOverride, "PointerOverride"},
+	{ValueOverride, "ValueOverride"},
+	{SuppressDirective, "SuppressDirective"},
+	{PointerDirective, "PointerDirective"},
+	{ValueDirective, "ValueDirective"},
+	{PointerVar, "PointerVar"},
+	{ValueVar, "ValueVar"},
+	{PointerPackageVar, "PointerPackageVar"},
+	{ValuePackageVar, "ValuePackageVar"},
+	{PointerAlias, "PointerAlias"},
+	{ValueAlias, "ValueAlias"},
+	{PointerConstructor, "PointerConstructor"},
+	{ValueConstructor, "ValueConstructor"},
+	{PointerReturn, "PointerReturn"},
+	{ValueReturn, "ValueReturn"},
+	{PointerAssert, "PointerAssert"},
+	{ValueAssert, "ValueAssert"},
+	{PointerTarget, "PointerTarget"},
+	{ValueTarget, "ValueTarget"},
+	{PointerLiteral, "PointerLiteral"},
+	{ValueLiteral, "ValueLiteral"},
+	{PointerCast, "PointerCast"},
+	{ValueCast, "ValueCast"},
+	{PointerDoc, "PointerDoc"},
+	{ValueDoc, "ValueDoc"},
+	{PointerReceivers, "PointerReceivers"},
+	{ValueReceivers, "ValueReceivers"},
+	{PointerTest, "PointerTest"},
+	{ValueTest, "ValueTest"},
+	{PointerVote, "PointerVote"},
+	{ValueVote, "ValueVote"},
+	{PointerDef, "PointerDef"},
+	{NonStruct, "NonStruct"},
+	{ErrorOnly, "ErrorOnly"},
+}
+
 func TestErrorProperty_String(t *testing.T) {
 	t.Parallel()
 
-	input := ErrorProperty((1 << 23) - 1)
-	actual := input.String()
+	var (
+		input    ErrorProperty
+		expected = make([]string, 0, len(declared))
+	)
+
+	for i, d := range declared {
+		if d.property != 1<<i {
+			t.Errorf("Property %s is %d, expected bit %d", d.name, int64(d.property), i)
+		}
+
+		if actual := d.property.String(); actual != d.name {
+			t.Errorf("Got %q, expected %q", actual, d.name)
+		}
+
+		input |= d.property
+		expected = append(expected, d.name)
+	}
+
+	actual := strings.Split(input.String(), ", ")
+	for _, name := range actual {
+		if strings.HasPrefix(name, "Unknown") {
+			t.Errorf("Got unnamed property %q", name)
+		}
+	}
+
+	if got, want := strings.Join(actual, ", "), strings.Join(expected, ", "); got != want {
+		t.Errorf("Got %q, expected %q", got, want)
+	}
 
-	seen := make(map[string]struct{})
-	for _, word := range strings.Split(actual, ", ") {
-		seen[word] = struct{}{}
+	// The bit after the last declared property has no name, so the list above is complete.
+	if next := (input + 1).String(); !strings.HasPrefix(next, "Unknown") {
+		t.Errorf("Got %q for the next bit, expected an undeclared property", next)
 	}
 
-	if len(seen) != 23 {
-		t.Fail()
+	if None.String() != "None" {
+		t.Errorf("Got %q, expected %q", None.String(), "None")
 	}
 }
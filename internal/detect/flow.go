@@ -0,0 +1,56 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package detect
+
+import (
+	"golang.org/x/tools/go/ssa"
+
+	"fillmore-labs.com/errortype/internal/flow"
+	"fillmore-labs.com/errortype/internal/typeutil"
+)
+
+// processFlows records error types returned through local variables, e.g.
+// `var err error = &T{}; return err`, which are invisible to [pass.processUsage].
+// Packages with type errors have no SSA form and are skipped.
+func (p pass) processFlows(funcs []*ssa.Function) {
+	for _, fn := range funcs {
+		p := p.inFile(fn.Pos())
+
+		for source := range flow.Sources(fn) {
+			if source.Sink != flow.SinkReturn {
+				continue
+			}
+
+			tn, isPtr, ok := typeutil.TypeNameOf(source.Value.Type())
+			if !ok {
+				continue // Not a named type.
+			}
+
+			property := ValueReturn
+			if isPtr {
+				property = PointerReturn
+			}
+
+			pos := source.Value.Pos()
+			if source.Expr != nil {
+				pos = source.Expr.Pos()
+			}
+
+			p.addTypePropertyInCurrentPackage(tn, property, pos)
+		}
+	}
+}
@@ -18,10 +18,10 @@ package detect
 
 import (
 	"log/slog"
+	"regexp"
+	"sync"
 
-	"fillmore-labs.com/errortype/internal/errortypes"
 	"fillmore-labs.com/errortype/internal/overrides"
-	"fillmore-labs.com/errortype/internal/typeutil"
 )
 
 // HeuristicPass represents a set of heuristic flags used to control various passes in the analysis process.
@@ -33,25 +33,88 @@ const (
 
 	// HeuristicReceivers represents a heuristic pass for consistent method receivers.
 	HeuristicReceivers
+
+	// HeuristicConstructors represents a heuristic pass for constructor functions.
+	HeuristicConstructors
+
+	// HeuristicDocs represents a heuristic pass for references in doc comments.
+	HeuristicDocs
 )
 
 type options struct {
 	// usageOverrides stores the usage configuration for error types, read from a file.
-	usageOverrides map[typeutil.TypeName]errortypes.ErrorType
+	usageOverrides overrideSet
+
+	// overridesLenient logs problems in override files instead of failing
+	overridesLenient bool
+
+	// overridesErr holds the problems of override files read before -overrides-lenient was set,
+	// reported when the analysis starts unless the flag is set then
+	overridesErr error
+
+	// overridesProblems holds the problems ignored when reading these files leniently
+	overridesProblems []string
+
+	// scopes store overrides applying only to matching consuming packages, read from a file.
+	scopes []scope
+
+	// pins collects the resolution of version-pinned overrides
+	pins *Pins
+
+	// overrideUsage collects how overrides applied
+	overrideUsage *OverrideUsage
+
+	// sentinels configures which package-level variables declare error types
+	sentinels sentinels
+
+	// sentinelsFlag is set after the first -sentinels flag, so that further ones add patterns
+	sentinelsFlag bool
 
 	// heuristics controls heuristic passes
 	heuristics HeuristicPass
 
+	// ladder holds the precedence of evidence and the type defaults
+	ladder ladder
+
+	// vote holds the usage of error types determined by a module-wide vote.
+	vote *Vote
+
+	// policy requires usages of error types in the current package
+	policy Policy
+
+	// requireDecl reports exported error types without explicit usage declarations
+	requireDecl bool
+
 	// debug controls debug output
 	debug bool
+
+	// logLadder logs the effective ladder once with debug output
+	logLadder sync.Once
+
+	// registerOverrides registers the overrides for usage collection once
+	registerOverrides sync.Once
+
+	// logOverridesProblems logs the problems of override files read before -overrides-lenient was set once
+	logOverridesProblems sync.Once
+
+	// overrideConflicts holds the type names with logged conflicts between overrides in different files
+	overrideConflicts sync.Map
 }
 
 // defaultOptions returns a [options] struct initialized with default values.
 func defaultOptions() *options {
 	return &options{ // Default options
-		usageOverrides: nil,
-		heuristics:     HeuristicUsage | HeuristicReceivers,
-		debug:          false,
+		usageOverrides:   overrideSet{},
+		overridesLenient: false,
+		scopes:           nil,
+		pins:             nil,
+		sentinels:        sentinels{patterns: []*regexp.Regexp{defaultSentinelPattern}, packageVars: false},
+		heuristics:       HeuristicUsage | HeuristicConstructors | HeuristicReceivers,
+		ladder:           defaultLadder,
+		vote:             nil,
+		policy:           Policy{},
+		requireDecl:      false,
+		debug:            false,
 	}
 }
 
@@ -120,6 +183,98 @@ func (o overridesOption) key() string { return "overrides" }
 
 func (o overridesOption) apply(opts *options) { opts.addOverrides(o.overrides) }
 
+// WithScope returns an Option that applies the provided overrides only in consuming packages
+// matching one of the package patterns, like `example.com/app/internal/legacy/...`.
+// Facts exported to other packages are not affected.
+func WithScope(scope overrides.Scope) Option {
+	return scopeOption{scope: scope}
+}
+
+type scopeOption struct {
+	scope overrides.Scope
+}
+
+// LogValue implements Option.
+func (o scopeOption) LogValue() slog.Value {
+	as := []slog.Attr{slog.Any("packages", o.scope.Packages)}
+	for _, usage := range o.scope.Overrides {
+		as = append(as, slog.Attr{
+			Key:   usage.TypeName.String(),
+			Value: slog.StringValue(usage.ErrorType.String()),
+		})
+	}
+
+	return slog.GroupValue(as...)
+}
+
+func (o scopeOption) key() string { return "scope" }
+
+func (o scopeOption) apply(opts *options) { opts.addScope(o.scope) }
+
+// WithPins is an [Option] to collect the module versions version-pinned overrides are resolved against.
+func WithPins(pins *Pins) Option {
+	return pinsOption{pins: pins}
+}
+
+type pinsOption struct{ pins *Pins }
+
+// LogValue implements Option.
+func (o pinsOption) LogValue() slog.Value { return slog.BoolValue(o.pins != nil) }
+
+func (o pinsOption) key() string { return "pins" }
+
+func (o pinsOption) apply(opts *options) { opts.pins = o.pins }
+
+// WithOverrideUsage is an [Option] to collect how overrides apply, reporting overrides
+// matching no type, impossible overrides, and overrides made redundant by autodetection.
+func WithOverrideUsage(usage *OverrideUsage) Option {
+	return overrideUsageOption{usage: usage}
+}
+
+type overrideUsageOption struct{ usage *OverrideUsage }
+
+// LogValue implements Option.
+func (o overrideUsageOption) LogValue() slog.Value { return slog.BoolValue(o.usage != nil) }
+
+func (o overrideUsageOption) key() string { return "override-usage" }
+
+func (o overrideUsageOption) apply(opts *options) { opts.overrideUsage = o.usage }
+
+// WithSentinelPatterns is an [Option] to configure the patterns matching the names of sentinel errors.
+// Patterns are matched against the variable name and its qualified name, like `example.com/errs.NotFound`.
+func WithSentinelPatterns(patterns ...*regexp.Regexp) Option {
+	return sentinelPatternsOption{patterns: patterns}
+}
+
+type sentinelPatternsOption struct{ patterns []*regexp.Regexp }
+
+// LogValue implements the [slog.LogValuer] interface.
+func (o sentinelPatternsOption) LogValue() slog.Value {
+	v := make([]string, 0, len(o.patterns))
+	for _, pattern := range o.patterns {
+		v = append(v, pattern.String())
+	}
+
+	return slog.AnyValue(v)
+}
+
+func (o sentinelPatternsOption) key() string { return "sentinels" }
+
+func (o sentinelPatternsOption) apply(opts *options) { opts.sentinels.patterns = o.patterns }
+
+// WithPackageVars is an [Option] to treat all package-level variables of concrete error types
+// as declarations of their usage, with a lower precedence than sentinel errors.
+func WithPackageVars(packageVars bool) Option { return packageVarsOption{packageVars: packageVars} }
+
+type packageVarsOption struct{ packageVars bool }
+
+// LogValue implements the [slog.LogValuer] interface.
+func (o packageVarsOption) LogValue() slog.Value { return slog.BoolValue(o.packageVars) }
+
+func (o packageVarsOption) key() string { return "packagevars" }
+
+func (o packageVarsOption) apply(opts *options) { opts.sentinels.packageVars = o.packageVars }
+
 // WithHeuristics is an [Option] to configure heuristic passes.
 func WithHeuristics(heuristics ...HeuristicPass) Option {
 	var combined HeuristicPass
@@ -143,6 +298,8 @@ func (o heuristicsOption) LogValue() slog.Value {
 			heuristic HeuristicPass
 		}{
 			{"usage", HeuristicUsage},
+			{"constructors", HeuristicConstructors},
+			{"docs", HeuristicDocs},
 			{"receivers", HeuristicReceivers},
 		} {
 			if o.heuristics&mask.heuristic != 0 {
@@ -158,6 +315,79 @@ func (o heuristicsOption) key() string { return "heuristics" }
 
 func (o heuristicsOption) apply(opts *options) { opts.heuristics = o.heuristics }
 
+// WithPrecedence is an [Option] to configure the precedence of evidence, see [ParsePrecedence].
+func WithPrecedence(precedence Precedence) Option { return precedenceOption{precedence: precedence} }
+
+type precedenceOption struct{ precedence Precedence }
+
+// LogValue implements the [slog.LogValuer] interface.
+func (o precedenceOption) LogValue() slog.Value { return slog.StringValue(o.precedence.String()) }
+
+func (o precedenceOption) key() string { return "precedence" }
+
+func (o precedenceOption) apply(opts *options) { opts.ladder.precedence = o.precedence }
+
+// WithTypeDefaults is an [Option] to configure defaults for error types without decisive evidence.
+func WithTypeDefaults(defaults ...TypeDefault) Option {
+	var combined TypeDefault
+	for _, d := range defaults {
+		combined |= d
+	}
+
+	return typeDefaultsOption{defaults: combined}
+}
+
+type typeDefaultsOption struct{ defaults TypeDefault }
+
+// LogValue implements the [slog.LogValuer] interface.
+func (o typeDefaultsOption) LogValue() slog.Value { return slog.StringValue(o.defaults.String()) }
+
+func (o typeDefaultsOption) key() string { return "defaults" }
+
+func (o typeDefaultsOption) apply(opts *options) { opts.ladder.defaults = o.defaults }
+
+// WithVote is an [Option] to use the usage of error types determined by a module-wide vote.
+// The vote is filled by the caller between analysis runs.
+func WithVote(vote *Vote) Option { return voteOption{vote: vote} }
+
+type voteOption struct{ vote *Vote }
+
+// LogValue implements the [slog.LogValuer] interface.
+func (o voteOption) LogValue() slog.Value { return slog.IntValue(len(o.vote.types)) }
+
+func (o voteOption) key() string { return "vote" }
+
+func (o voteOption) apply(opts *options) { opts.vote = o.vote }
+
+// WithPolicy is an [Option] to report error types in the analyzed packages whose usage violates the policy.
+func WithPolicy(policy Policy) Option { return policyOption{policy: policy} }
+
+type policyOption struct{ policy Policy }
+
+// LogValue implements the [slog.LogValuer] interface.
+func (o policyOption) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("requirements", o.policy.String()),
+		slog.Any("exceptions", o.policy.Exceptions),
+	)
+}
+
+func (o policyOption) key() string { return "policy" }
+
+func (o policyOption) apply(opts *options) { opts.policy = o.policy }
+
+// WithRequireDecl is an [Option] to report exported error types whose usage is not declared explicitly.
+func WithRequireDecl(requireDecl bool) Option { return requireDeclOption{requireDecl: requireDecl} }
+
+type requireDeclOption struct{ requireDecl bool }
+
+// LogValue implements the [slog.LogValuer] interface.
+func (o requireDeclOption) LogValue() slog.Value { return slog.BoolValue(o.requireDecl) }
+
+func (o requireDeclOption) key() string { return "require-decl" }
+
+func (o requireDeclOption) apply(opts *options) { opts.requireDecl = o.requireDecl }
+
 // WithDebug is an [Option] to configure debug output.
 func WithDebug(debug bool) Option { return debugOption{debug: debug} }
 
@@ -17,46 +17,226 @@
 package detect
 
 import (
+	"errors"
 	"fmt"
-	"os"
-	"path/filepath"
+	"log"
+	"regexp"
 	"strings"
 
+	"fillmore-labs.com/errortype/internal/errortypes"
 	"fillmore-labs.com/errortype/internal/overrides"
 )
 
 // readOverrides reads error type usage overrides from the specified file.
 // If fileName is empty, no action is taken.
+//
+// Since -overrides-lenient may follow on the command line, a file with invalid entries
+// is read leniently, and its problems are reported by [options.checkOverrides].
 func (o *options) readOverrides(fileName string) error {
 	if fileName == "" {
 		return nil
 	}
 
-	overridesFile, err := os.Open(filepath.Clean(fileName))
+	config, err := overrides.Reader{Lenient: o.overridesLenient}.ReadFile(fileName)
+	if err != nil && !o.overridesLenient {
+		strictErr := fmt.Errorf("can't read overrides file %s: %w", fileName, err)
+
+		rd := overrides.Reader{Lenient: true, Logf: func(format string, args ...any) {
+			o.overridesProblems = append(o.overridesProblems, fmt.Sprintf(format, args...))
+		}}
+
+		if config, err = rd.ReadFile(fileName); err == nil {
+			o.overridesErr = errors.Join(o.overridesErr, strictErr)
+		}
+	}
+
 	if err != nil {
-		return fmt.Errorf("can't open overrides file: %w", err)
+		return fmt.Errorf("can't read overrides file %s: %w", fileName, err)
+	}
+
+	o.addOverrides(config.Overrides)
+
+	for _, scope := range config.Scopes {
+		o.addScope(scope)
+	}
+
+	if len(config.Sentinels) > 0 {
+		patterns, err := compilePatterns(config.Sentinels)
+		if err != nil {
+			return fmt.Errorf("invalid sentinels in overrides file %s: %w", fileName, err)
+		}
+
+		o.sentinels.patterns = patterns
 	}
 
-	defer overridesFile.Close()
+	requirements := make(map[string]string)
+	if config.Policy.Struct != "" {
+		requirements["struct"] = config.Policy.Struct
+	}
 
-	usageOverrides, err := overrides.Read(overridesFile)
+	if config.Policy.NonStruct != "" {
+		requirements["nonstruct"] = config.Policy.NonStruct
+	}
+
+	if len(requirements) > 0 {
+		if err := o.updatePolicy(requirements); err != nil {
+			return fmt.Errorf("invalid policy in overrides file %s: %w", fileName, err)
+		}
+	}
+
+	if len(config.Policy.Exceptions) > 0 {
+		o.policy.Exceptions = config.Policy.Exceptions
+	}
+
+	if len(config.Precedence) > 0 {
+		precedence, err := ParsePrecedence(config.Precedence...)
+		if err != nil {
+			return fmt.Errorf("invalid precedence in overrides file %s: %w", fileName, err)
+		}
+
+		o.ladder.precedence = precedence
+	}
+
+	if len(config.Defaults) > 0 {
+		if err := o.updateTypeDefaults(config.Defaults); err != nil {
+			return fmt.Errorf("invalid defaults in overrides file %s: %w", fileName, err)
+		}
+	}
+
+	return nil
+}
+
+// checkOverrides returns the problems of override files read strictly, or logs them
+// once when -overrides-lenient is set.
+func (o *options) checkOverrides() error {
+	if o.overridesErr == nil {
+		return nil
+	}
+
+	if !o.overridesLenient {
+		return o.overridesErr
+	}
+
+	o.logOverridesProblems.Do(func() {
+		for _, problem := range o.overridesProblems {
+			log.Print(problem)
+		}
+	})
+
+	return nil
+}
+
+// setSentinelPatterns parses and adds a pattern matching the names of sentinel errors.
+// The first pattern replaces the configured ones, so the flag can be repeated like the
+// `sentinels:` list of the overrides file.
+func (o *options) setSentinelPatterns(expr string) error {
+	patterns, err := compilePatterns([]string{expr})
 	if err != nil {
-		return fmt.Errorf("can't read overrides file %s: %w", fileName, err)
+		return err
 	}
 
-	o.addOverrides(usageOverrides)
+	if !o.sentinelsFlag {
+		o.sentinels.patterns = nil
+		o.sentinelsFlag = true
+	}
+
+	o.sentinels.patterns = append(o.sentinels.patterns, patterns...)
+
+	return nil
+}
+
+// compilePatterns compiles a list of regular expressions.
+func compilePatterns(exprs []string) ([]*regexp.Regexp, error) {
+	patterns := make([]*regexp.Regexp, 0, len(exprs))
+
+	for _, expr := range exprs {
+		pattern, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sentinel pattern %q: %w", expr, err)
+		}
+
+		patterns = append(patterns, pattern)
+	}
+
+	return patterns, nil
+}
+
+// setPolicy parses and sets the policy requirements from a comma-separated list like "struct=pointer,nonstruct=value".
+func (o *options) setPolicy(list string) error {
+	requirements := make(map[string]string)
+
+	for _, r := range strings.FieldsFunc(list, func(r rune) bool { return r == ',' }) {
+		kind, usage, ok := strings.Cut(r, "=")
+		if !ok {
+			return fmt.Errorf("invalid policy requirement %q, expected kind=usage", r) //nolint:err113
+		}
+
+		requirements[strings.TrimSpace(kind)] = strings.TrimSpace(usage)
+	}
+
+	return o.updatePolicy(requirements)
+}
+
+// updatePolicy sets the policy requirements from a map of kinds ("struct" or "nonstruct")
+// to usages ("pointer", "value", or "any").
+func (o *options) updatePolicy(requirements map[string]string) error {
+	for kind, usage := range requirements {
+		var errorType errortypes.ErrorType
+
+		switch usage {
+		case "pointer":
+			errorType = errortypes.PointerType
+
+		case "value":
+			errorType = errortypes.ValueType
+
+		case "any":
+			errorType = errortypes.Undecided
+
+		default:
+			return fmt.Errorf("unknown usage %q for %q in policy", usage, kind) //nolint:err113
+		}
+
+		switch kind {
+		case "struct":
+			o.policy.Struct = errorType
+
+		case "nonstruct":
+			o.policy.NonStruct = errorType
+
+		default:
+			return fmt.Errorf("unknown kind %q in policy", kind) //nolint:err113
+		}
+	}
+
+	return nil
+}
+
+// setPolicyExceptions sets the packages and types exempt from the policy from a comma-separated list.
+func (o *options) setPolicyExceptions(list string) error {
+	var exceptions []string
+
+	for _, e := range strings.FieldsFunc(list, func(r rune) bool { return r == ',' }) {
+		if e = strings.TrimSpace(e); e != "" {
+			exceptions = append(exceptions, e)
+		}
+	}
+
+	o.policy.Exceptions = exceptions
 
 	return nil
 }
 
 // setHeuristics parses and sets the heuristic passes from a comma-separated list.
-// Valid values are: "usage", "receivers", and "off".
+// Valid values are: "usage", "constructors", "docs", "receivers", and "off".
 // "off" disables all heuristics and cannot be combined with other values.
 func (o *options) setHeuristics(list string) error {
 	const (
-		HeuristicOffName       = "off"
-		HeuristicUsageName     = "usage"
-		HeuristicReceiversName = "receivers"
+		HeuristicOffName          = "off"
+		HeuristicUsageName        = "usage"
+		HeuristicConstructorsName = "constructors"
+		HeuristicDocsName         = "docs"
+		HeuristicReceiversName    = "receivers"
 	)
 
 	var (
@@ -74,6 +254,12 @@ func (o *options) setHeuristics(list string) error {
 		case HeuristicUsageName:
 			heuristics |= HeuristicUsage
 
+		case HeuristicConstructorsName:
+			heuristics |= HeuristicConstructors
+
+		case HeuristicDocsName:
+			heuristics |= HeuristicDocs
+
 		case HeuristicReceiversName:
 			heuristics |= HeuristicReceivers
 
@@ -93,3 +279,70 @@ func (o *options) setHeuristics(list string) error {
 
 	return nil
 }
+
+// setPrecedence parses and sets the precedence of evidence from a comma-separated list of ranks.
+func (o *options) setPrecedence(list string) error {
+	ranks := strings.FieldsFunc(list, func(r rune) bool { return r == ',' })
+	if len(ranks) == 0 {
+		return nil
+	}
+
+	precedence, err := ParsePrecedence(ranks...)
+	if err != nil {
+		return err
+	}
+
+	o.ladder.precedence = precedence
+
+	return nil
+}
+
+// setTypeDefaults parses and sets the type defaults from a comma-separated list.
+func (o *options) setTypeDefaults(list string) error {
+	return o.updateTypeDefaults(strings.FieldsFunc(list, func(r rune) bool { return r == ',' }))
+}
+
+// updateTypeDefaults sets the type defaults from a list of names.
+// Valid values are: "non-struct", "error-only", and "off".
+// "off" disables all defaults and cannot be combined with other values.
+func (o *options) updateTypeDefaults(names []string) error {
+	const (
+		TypeDefaultOffName       = "off"
+		TypeDefaultNonStructName = "non-struct"
+		TypeDefaultErrorOnlyName = "error-only"
+	)
+
+	var (
+		defaults TypeDefault
+		hasOff   bool
+	)
+
+	for _, d := range names {
+		switch strings.TrimSpace(d) {
+		case "":
+
+		case TypeDefaultOffName:
+			hasOff = true
+
+		case TypeDefaultNonStructName:
+			defaults |= TypeDefaultNonStruct
+
+		case TypeDefaultErrorOnlyName:
+			defaults |= TypeDefaultErrorOnly
+
+		default:
+			return fmt.Errorf("unknown default %q", d) //nolint:err113
+		}
+	}
+
+	if hasOff && defaults != 0 {
+		return fmt.Errorf(`default "off" cannot be combined with other values in %q`, names) //nolint:err113
+	}
+
+	// Only update if the user provided some values.
+	if defaults != 0 || hasOff {
+		o.ladder.defaults = defaults
+	}
+
+	return nil
+}
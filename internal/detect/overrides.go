@@ -17,50 +17,334 @@
 package detect
 
 import (
+	"cmp"
 	"go/types"
 	"log"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
 
 	"fillmore-labs.com/errortype/internal/errortypes"
 	"fillmore-labs.com/errortype/internal/overrides"
 	"fillmore-labs.com/errortype/internal/typeutil"
 )
 
+// overrideSet holds the usage overrides for error types, by exact name and by pattern.
+type overrideSet struct {
+	// pinned maps type names to their overridden usage for matching module versions.
+	pinned map[typeutil.TypeName][]pinnedOverride
+
+	// exact maps type names to their overrides.
+	exact map[typeutil.TypeName]overrides.Override
+
+	// patterns match type names not listed in exact, sorted from the most specific to the broadest.
+	patterns []overridePattern
+}
+
+// pinnedOverride is an override like `example.com/lib@<v1.5.0.ParseError` applying only to matching module versions.
+type pinnedOverride struct {
+	override  overrides.Override
+	entry     string
+	versions  overrides.Versions
+	errorType errortypes.ErrorType
+}
+
+// overridePattern is a pattern like `example.com/errs/....*Error` matching qualified type names.
+type overridePattern struct {
+	override    overrides.Override
+	pattern     string
+	entry       string // The pinned pattern as written in the overrides file, empty for unpinned patterns.
+	re          *regexp.Regexp
+	versions    overrides.Versions
+	errorType   errortypes.ErrorType
+	specificity int
+}
+
+// overrideMatch describes the override applying to a type.
+type overrideMatch struct {
+	override  overrides.Override // The entry in the overrides file.
+	errorType errortypes.ErrorType
+	pattern   string             // The matching pattern, empty for exact type names.
+	versions  overrides.Versions // The matching version constraint, empty for unpinned overrides.
+}
+
+// isOverridePattern reports whether an override entry is a pattern instead of an exact type name.
+func isOverridePattern(name string) bool {
+	return strings.Contains(name, "...") || strings.ContainsAny(name, "*?")
+}
+
+// newOverridePattern compiles a pattern matching qualified type names. Like package patterns
+// of the go command, a trailing "/..." also matches the types of the package itself.
+func newOverridePattern(override overrides.Override) overridePattern {
+	pattern := override.TypeName.String()
+	re, specificity := compileGlob(pattern, `(/.*|\.[^/]*)`)
+
+	var entry string
+	if override.Versions != "" {
+		entry = override.Entry()
+	}
+
+	return overridePattern{
+		override:    override,
+		pattern:     pattern,
+		entry:       entry,
+		re:          re,
+		versions:    override.Versions,
+		errorType:   override.ErrorType,
+		specificity: specificity,
+	}
+}
+
+// compileGlob compiles a pattern where "..." matches any string, "*" matches any string
+// not containing a slash and "?" matches any single character except a slash. A trailing "/..."
+// is replaced by the regular expression trailing. It also returns the number of literal characters.
+func compileGlob(pattern, trailing string) (*regexp.Regexp, int) {
+	var (
+		b           strings.Builder
+		specificity int
+	)
+
+	b.WriteByte('^')
+
+	for rest := pattern; rest != ""; {
+		switch {
+		case rest == "/...":
+			b.WriteString(trailing)
+			rest = ""
+
+		case strings.HasPrefix(rest, "..."):
+			b.WriteString(".*")
+			rest = rest[3:]
+
+		case rest[0] == '*':
+			b.WriteString("[^/]*")
+			rest = rest[1:]
+
+		case rest[0] == '?':
+			b.WriteString("[^/]")
+			rest = rest[1:]
+
+		default:
+			b.WriteString(regexp.QuoteMeta(rest[:1]))
+			rest = rest[1:]
+			specificity++
+		}
+	}
+
+	b.WriteByte('$')
+
+	return regexp.MustCompile(b.String()), specificity
+}
+
 func (o *options) addOverrides(overrides []overrides.Override) {
-	if o.usageOverrides == nil {
-		o.usageOverrides = make(map[typeutil.TypeName]errortypes.ErrorType)
+	o.usageOverrides.add(overrides)
+}
+
+// add adds overrides to the set, later overrides taking precedence over earlier ones.
+func (s *overrideSet) add(entries []overrides.Override) {
+	for _, override := range entries {
+		if isOverridePattern(override.TypeName.String()) {
+			s.addPattern(newOverridePattern(override))
+
+			continue
+		}
+
+		if override.Versions != "" {
+			if s.pinned == nil {
+				s.pinned = make(map[typeutil.TypeName][]pinnedOverride)
+			}
+
+			s.pinned[override.TypeName] = append(s.pinned[override.TypeName], pinnedOverride{
+				override:  override,
+				entry:     override.Entry(),
+				versions:  override.Versions,
+				errorType: override.ErrorType,
+			})
+
+			continue
+		}
+
+		if s.exact == nil {
+			s.exact = make(map[typeutil.TypeName]overrides.Override)
+		}
+
+		// Conflicts within a file are reported when reading it.
+		if previous, ok := s.exact[override.TypeName]; ok {
+			logConflict(override.TypeName.String(), previous, override)
+		}
+
+		s.exact[override.TypeName] = override
+	}
+
+	// More specific patterns win over broader ones, sort for a deterministic order.
+	slices.SortStableFunc(s.patterns, func(a, b overridePattern) int {
+		if c := cmp.Compare(b.specificity, a.specificity); c != 0 {
+			return c
+		}
+
+		if pa, pb := a.versions != "", b.versions != ""; pa != pb {
+			if pa {
+				return -1 // Pinned patterns are more specific.
+			}
+
+			return 1
+		}
+
+		if c := strings.Compare(a.pattern, b.pattern); c != 0 {
+			return c
+		}
+
+		return strings.Compare(string(a.versions), string(b.versions))
+	})
+}
+
+// addPattern adds a pattern to the set, replacing an earlier entry of the same pattern and versions.
+func (s *overrideSet) addPattern(pattern overridePattern) {
+	for i, previous := range s.patterns {
+		if previous.pattern == pattern.pattern && previous.versions == pattern.versions {
+			logConflict(pattern.override.Entry(), previous.override, pattern.override)
+			s.patterns[i] = pattern
+
+			return
+		}
 	}
 
-	for _, override := range overrides {
-		o.usageOverrides[override.TypeName] = override.ErrorType
+	s.patterns = append(s.patterns, pattern)
+}
+
+// logConflict logs overrides of the same entry or type in different files with a different usage,
+// where override takes precedence over previous. Conflicts within a file are reported when reading it.
+func logConflict(entry string, previous, override overrides.Override) {
+	if previous.ErrorType == override.ErrorType || previous.Pos.File == override.Pos.File {
+		return
+	}
+
+	log.Printf("Conflicting overrides for %s: %s at %s, %s at %s; using %s",
+		entry, previous.ErrorType, previous.Pos, override.ErrorType, override.Pos, override.ErrorType)
+}
+
+// lookup returns the override applying to a type name in a module version.
+// Pinned overrides take precedence over exact type names, which take precedence over patterns.
+// An empty version is unknown and only matches unpinned overrides. When pins is not nil,
+// the resolution of pinned type names and pinned patterns matching the type name is recorded.
+func (s overrideSet) lookup(typeName typeutil.TypeName, version string, pins *Pins) (overrideMatch, bool) {
+	matches := s.matches(typeName, version, pins)
+	if len(matches) == 0 {
+		return overrideMatch{}, false
 	}
+
+	return matches[0], true
 }
 
-func (p pass) processOverrides(overrides map[typeutil.TypeName]errortypes.ErrorType) {
+// matches returns all overrides applying to a type name in a module version, from the highest to the lowest
+// precedence, see [overrideSet.lookup].
+func (s overrideSet) matches(typeName typeutil.TypeName, version string, pins *Pins) []overrideMatch {
+	var matches []overrideMatch
+
+	for _, pin := range s.pinned[typeName] {
+		matched := pin.versions.Match(version)
+		pins.record(pin.entry, version, matched)
+
+		if matched {
+			matches = append(matches, overrideMatch{override: pin.override, errorType: pin.errorType, versions: pin.versions})
+		}
+	}
+
+	if override, ok := s.exact[typeName]; ok {
+		matches = append(matches, overrideMatch{override: override, errorType: override.ErrorType})
+	}
+
+	if len(s.patterns) == 0 {
+		return matches
+	}
+
+	name := typeName.String()
+	for _, pattern := range s.patterns {
+		if !pattern.re.MatchString(name) {
+			continue
+		}
+
+		if pattern.versions != "" {
+			matched := pattern.versions.Match(version)
+			pins.record(pattern.entry, version, matched)
+
+			if !matched {
+				continue
+			}
+		}
+
+		matches = append(matches, overrideMatch{
+			override:  pattern.override,
+			errorType: pattern.errorType,
+			pattern:   pattern.pattern,
+			versions:  pattern.versions,
+		})
+	}
+
+	return matches
+}
+
+// logConflicts logs overrides in different files applying to a type with a different usage than the one
+// taking precedence, like a pattern in one file and the exact type name in another, once per type.
+func logConflicts(typeName typeutil.TypeName, matches []overrideMatch, logged *sync.Map) {
+	if len(matches) < 2 {
+		return
+	}
+
+	override := matches[0].override
+	if !slices.ContainsFunc(matches[1:], func(m overrideMatch) bool {
+		return m.errorType != override.ErrorType && m.override.Pos.File != override.Pos.File
+	}) {
+		return
+	}
+
+	if _, loaded := logged.LoadOrStore(typeName, struct{}{}); loaded {
+		return
+	}
+
+	for _, m := range matches[1:] {
+		logConflict(typeName.String(), m.override, override)
+	}
+}
+
+// processOverrides applies the overrides to the types in the PropertyMap. It returns the overrides
+// matching types of the current package, including impossible ones, for [OverrideUsage].
+func (p pass) processOverrides(overrides overrideSet, pins *Pins, conflicts *sync.Map, debug bool) map[*types.TypeName]overrideMatch {
+	local := make(map[*types.TypeName]overrideMatch)
+
 	for tn, property := range p.PropertyMap {
 		typeName := typeutil.NewTypeName(tn)
 
-		usage, ok := overrides[typeName]
-		if !ok {
+		version, typePins := p.moduleVersion(tn), pins
+		if tn.Pkg() != p.Pkg {
+			typePins = nil // Pinned overrides are resolved when analyzing the defining package.
+		}
+
+		matches := overrides.matches(typeName, version, typePins)
+		if len(matches) == 0 {
 			continue
 		}
 
+		logConflicts(typeName, matches, conflicts)
+
+		match := matches[0]
+
+		if tn.Pkg() == p.Pkg {
+			local[tn] = match
+		}
+
 		// Check whether the override is valid.
+		usage := match.errorType
+		if !implementsUsage(tn, usage) {
+			continue
+		}
+
 		switch usage {
 		case errortypes.PointerType:
-			ptrType := types.NewPointer(tn.Type())
-			if !typeutil.HasErrorMethod(ptrType) {
-				log.Printf("Pointer override \"*%s\" does not implement the error interface", typeName)
-
-				continue
-			}
 			property |= PointerOverride
 
 		case errortypes.ValueType:
-			if !typeutil.HasErrorMethod(tn.Type()) {
-				log.Printf("Value override \"%s\" does not implement the error interface", typeName)
-
-				continue
-			}
 			property |= ValueOverride
 
 		case errortypes.SuppressType:
@@ -70,6 +354,62 @@ func (p pass) processOverrides(overrides map[typeutil.TypeName]errortypes.ErrorT
 			continue
 		}
 
+		if debug {
+			match.log(typeName, version)
+		}
+
 		p.PropertyMap[tn] = property
 	}
+
+	return local
+}
+
+// implementsUsage reports whether a type implements the error interface with the given usage.
+func implementsUsage(tn *types.TypeName, usage errortypes.ErrorType) bool {
+	switch usage {
+	case errortypes.PointerType:
+		return typeutil.HasErrorMethod(types.NewPointer(tn.Type()))
+
+	case errortypes.ValueType:
+		return typeutil.HasErrorMethod(tn.Type())
+
+	case errortypes.SuppressType:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// usageTypeString returns the type name as used with the given usage, like "*pkg.Type".
+func usageTypeString(typeName typeutil.TypeName, usage errortypes.ErrorType) string {
+	if usage == errortypes.PointerType {
+		return "*" + typeName.String()
+	}
+
+	return typeName.String()
+}
+
+// log logs which pattern or version constraint applied to a type.
+func (m overrideMatch) log(typeName typeutil.TypeName, version string) {
+	switch {
+	case m.pattern != "" && m.versions != "":
+		log.Printf("Override pattern %q for versions %q applies %s to %s %s", m.pattern, m.versions, m.errorType, typeName, version)
+
+	case m.pattern != "":
+		log.Printf("Override pattern %q applies %s to %s", m.pattern, m.errorType, typeName)
+
+	case m.versions != "":
+		log.Printf("Override for versions %q applies %s to %s %s", m.versions, m.errorType, typeName, version)
+	}
+}
+
+// moduleVersion returns the version of the module defining a type, when it is defined in the current package.
+// It is empty when unknown, like for the main module.
+func (p pass) moduleVersion(tn *types.TypeName) string {
+	if tn.Pkg() != p.Pkg || p.Module == nil {
+		return ""
+	}
+
+	return p.Module.Version
 }
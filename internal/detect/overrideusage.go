@@ -0,0 +1,200 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package detect
+
+import (
+	"cmp"
+	"fmt"
+	"go/types"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+
+	"fillmore-labs.com/errortype/internal/errortypes"
+	"fillmore-labs.com/errortype/internal/overrides"
+	"fillmore-labs.com/errortype/internal/typeutil"
+)
+
+// OverrideUsage collects how overrides applied to types during the analysis, to report overrides
+// matching no type, impossible overrides, and overrides made redundant by autodetection.
+//
+// OverrideUsage is safe for concurrent use by analysis runs.
+type OverrideUsage struct {
+	mu      sync.Mutex
+	entries map[overrides.Override]*overrideRecord
+}
+
+type overrideRecord struct {
+	effective  bool                 // Applied to a type, deciding its usage.
+	redundant  errortypes.ErrorType // Applied to a type autodetected with the same usage.
+	impossible []string             // Types not implementing the error interface with the usage.
+}
+
+// OverrideProblem is an override that did not affect the analysis.
+type OverrideProblem struct {
+	// Override is the entry in the overrides file.
+	Override overrides.Override
+	// Message describes the problem.
+	Message string
+	// Redundant is set when autodetection yields the usage of the override.
+	Redundant bool
+}
+
+// String returns the problem prefixed with the position of the override.
+func (o OverrideProblem) String() string {
+	return o.Override.Pos.String() + ": " + o.Message
+}
+
+// register adds the overrides of the sets.
+func (u *OverrideUsage) register(sets ...overrideSet) {
+	if u == nil {
+		return
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.entries == nil {
+		u.entries = make(map[overrides.Override]*overrideRecord)
+	}
+
+	add := func(override overrides.Override) {
+		if _, ok := u.entries[override]; !ok {
+			u.entries[override] = &overrideRecord{}
+		}
+	}
+
+	for _, s := range sets {
+		for _, pinned := range s.pinned {
+			for _, pin := range pinned {
+				add(pin.override)
+			}
+		}
+
+		for _, override := range s.exact {
+			add(override)
+		}
+
+		for _, pattern := range s.patterns {
+			add(pattern.override)
+		}
+	}
+}
+
+// record updates the record of an override.
+func (u *OverrideUsage) record(override overrides.Override, update func(r *overrideRecord)) {
+	if u == nil {
+		return
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if r, ok := u.entries[override]; ok {
+		update(r)
+	}
+}
+
+// Problems returns the overrides that did not affect the analysis, sorted by position.
+//
+// Pinned overrides and pinned patterns not matching any module version are reported by [Pins].
+func (u *OverrideUsage) Problems() []OverrideProblem {
+	if u == nil {
+		return nil
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	var problems []OverrideProblem
+
+	for override, r := range u.entries {
+		var (
+			message   string
+			redundant bool
+		)
+
+		switch name := override.Entry(); {
+		case r.effective:
+			continue
+
+		case r.redundant != errortypes.Undecided:
+			message = fmt.Sprintf("%s override for %s is redundant, autodetection already yields %s",
+				override.ErrorType, name, strings.ToLower(r.redundant.String()))
+			redundant = true
+
+		case len(r.impossible) > 0:
+			slices.Sort(r.impossible)
+			message = fmt.Sprintf("%s override for %s is impossible, %s does not implement the error interface",
+				override.ErrorType, name, strings.Join(slices.Compact(r.impossible), ", "))
+
+		case override.Versions != "":
+			continue
+
+		default:
+			message = fmt.Sprintf("%s override for %s matches no type", override.ErrorType, name)
+		}
+
+		problems = append(problems, OverrideProblem{Override: override, Message: message, Redundant: redundant})
+	}
+
+	slices.SortFunc(problems, func(a, b OverrideProblem) int {
+		pa, pb := a.Override.Pos, b.Override.Pos
+		if c := strings.Compare(pa.File, pb.File); c != 0 {
+			return c
+		}
+
+		if c := cmp.Compare(pa.Line, pb.Line); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(pa.Column, pb.Column)
+	})
+
+	return problems
+}
+
+// recordOverrides records how the overrides matching types of the current package applied.
+// An override is redundant when the evidence in the defining package yields the same usage.
+// Without usage collection, impossible overrides of exact type names are logged.
+func (p pass) recordOverrides(local map[*types.TypeName]overrideMatch, usage *OverrideUsage) {
+	for tn, match := range local {
+		typeName := typeutil.NewTypeName(tn)
+
+		switch {
+		case !implementsUsage(tn, match.errorType):
+			if usage == nil && match.pattern == "" {
+				log.Printf("%s override \"%s\" does not implement the error interface",
+					match.errorType, usageTypeString(typeName, match.errorType))
+			}
+
+			usage.record(match.override, func(r *overrideRecord) {
+				r.impossible = append(r.impossible, usageTypeString(typeName, match.errorType))
+			})
+
+		case usage == nil:
+			continue
+
+		case p.determinedType(p.PropertyMap[tn]&^OverrideMask) == match.errorType:
+			usage.record(match.override, func(r *overrideRecord) { r.redundant = match.errorType })
+
+		default:
+			usage.record(match.override, func(r *overrideRecord) { r.effective = true })
+		}
+	}
+}
@@ -19,10 +19,13 @@ package detect
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
+	"strings"
 
 	"golang.org/x/tools/go/analysis"
 
 	"fillmore-labs.com/errortype/internal/errortypes"
+	"fillmore-labs.com/errortype/internal/typeutil"
 )
 
 // pass holds the state for a single run of the detecttypes analyzer on a package.
@@ -33,16 +36,62 @@ type pass struct {
 	*analysis.Pass
 	errortypes.PropertyMap[ErrorProperty]
 	StyleCheck bool
+	funcValues typeutil.FuncValues
+	results    map[*types.Var]struct{} // Named error results of all functions seen so far.
+	inTest     bool                    // Usage is in a _test.go file.
+	ladder     ladder                  // Precedence of evidence and type defaults.
+	sites      sites                   // Positions where properties were found.
 }
 
 // newPass creates and initializes a new pass for the detecttypes analyzer.
-func newPass(ap *analysis.Pass) pass {
+func newPass(ap *analysis.Pass, l ladder) pass {
 	return pass{
 		Pass:        ap,
 		PropertyMap: errortypes.NewPropertyMap[ErrorProperty](),
+		funcValues:  typeutil.NewFuncValues(ap.TypesInfo, ap.Files),
+		results:     make(map[*types.Var]struct{}),
+		ladder:      l,
+		sites:       make(sites),
 	}
 }
 
+// determinedType returns the error type determined by the properties of a type using the configured ladder.
+func (p pass) determinedType(e ErrorProperty) errortypes.ErrorType {
+	return p.ladder.determinedType(e)
+}
+
+// HasUndeterminedErrors checks if the pass contains any types with undetermined error types.
+func (p pass) HasUndeterminedErrors() bool {
+	for _, errorType := range p.PropertyMap {
+		if p.determinedType(errorType) == errortypes.Undecided {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AllDetermined is an iterator over all types in the pass whose pointer-ness has been unambiguously determined,
+// together with the confidence of the decision.
+func (p pass) AllDetermined(yield func(*types.TypeName, errortypes.Determined) bool) {
+	for tn, errorType := range p.PropertyMap {
+		if determined := p.ladder.decide(errorType); determined.ErrorType != errortypes.Undecided {
+			if !yield(tn, determined) {
+				return
+			}
+		}
+	}
+}
+
+// inFile returns a copy of the pass for usage in the file containing pos.
+func (p pass) inFile(pos token.Pos) pass {
+	if f := p.Fset.File(pos); f != nil {
+		p.inTest = strings.HasSuffix(f.Name(), "_test.go")
+	}
+
+	return p
+}
+
 // AllTypeDecls is an iterator over all type specifications (*ast.TypeSpec) in the pass's files.
 func (p pass) AllTypeDecls(yield func(*ast.TypeSpec) bool) {
 	iterateOverSpecs(p.Files, token.TYPE, yield)
@@ -0,0 +1,135 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package detect
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Pins collects the module versions version-pinned overrides like `example.com/lib@<v1.5.0.ParseError`
+// or `example.com/lib@v1.4.0.*Error` were resolved against, to warn about overrides no longer matching any module version in the build.
+//
+// Pins is safe for concurrent use by analysis runs.
+type Pins struct {
+	mu   sync.Mutex
+	pins map[string]*pinRecord
+}
+
+type pinRecord struct {
+	matched  bool
+	versions map[string]struct{}
+}
+
+// UnmatchedPin is a version-pinned override that matched no module version in the build.
+type UnmatchedPin struct {
+	// Override is the override as written in the overrides file.
+	Override string
+	// Versions are the module versions found in the build, empty when the package was not analyzed.
+	// The version of the main module is empty.
+	Versions []string
+}
+
+// String returns a description of the unmatched override.
+func (u UnmatchedPin) String() string {
+	if len(u.Versions) == 0 {
+		return u.Override + " (not found in the build)"
+	}
+
+	versions := make([]string, len(u.Versions))
+	for i, v := range u.Versions {
+		if v == "" {
+			v = "(devel)"
+		}
+
+		versions[i] = v
+	}
+
+	return u.Override + " (found " + strings.Join(versions, ", ") + ")"
+}
+
+// register adds the pinned overrides and pinned patterns of a set.
+func (p *Pins) register(s overrideSet) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.pins == nil {
+		p.pins = make(map[string]*pinRecord)
+	}
+
+	add := func(entry string) {
+		if _, ok := p.pins[entry]; !ok {
+			p.pins[entry] = &pinRecord{versions: make(map[string]struct{})}
+		}
+	}
+
+	for _, pinned := range s.pinned {
+		for _, pin := range pinned {
+			add(pin.entry)
+		}
+	}
+
+	for _, pattern := range s.patterns {
+		if pattern.entry != "" {
+			add(pattern.entry)
+		}
+	}
+}
+
+// record records the resolution of a pinned override against a module version.
+func (p *Pins) record(entry, version string, matched bool) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	r, ok := p.pins[entry]
+	if !ok {
+		return
+	}
+
+	r.matched = r.matched || matched
+	r.versions[version] = struct{}{}
+}
+
+// Unmatched returns the pinned overrides that matched no module version in the build, sorted by override.
+func (p *Pins) Unmatched() []UnmatchedPin {
+	if p == nil {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var unmatched []UnmatchedPin
+
+	for _, entry := range slices.Sorted(maps.Keys(p.pins)) {
+		if r := p.pins[entry]; !r.matched {
+			unmatched = append(unmatched, UnmatchedPin{Override: entry, Versions: slices.Sorted(maps.Keys(r.versions))})
+		}
+	}
+
+	return unmatched
+}
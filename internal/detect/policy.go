@@ -0,0 +1,201 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package detect
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"fillmore-labs.com/errortype/internal/errortypes"
+	"fillmore-labs.com/errortype/internal/typeutil"
+)
+
+// Policy requires the error types defined in the analyzed packages to have a certain usage,
+// like "struct error types are pointers, other error types are values".
+type Policy struct {
+	// Struct is the required usage of struct error types, Undecided for no requirement.
+	Struct errortypes.ErrorType
+
+	// NonStruct is the required usage of other error types, Undecided for no requirement.
+	NonStruct errortypes.ErrorType
+
+	// Exceptions are package paths or qualified type names, like `example.com/errs.NotFound`,
+	// exempt from the policy.
+	Exceptions []string
+}
+
+// enabled reports whether the policy has any requirement.
+func (pol Policy) enabled() bool {
+	return pol.Struct != errortypes.Undecided || pol.NonStruct != errortypes.Undecided
+}
+
+// required returns the required usage of a type, or Undecided when there is no requirement.
+func (pol Policy) required(tn *types.TypeName) errortypes.ErrorType {
+	name := typeutil.NewTypeName(tn)
+	if slices.Contains(pol.Exceptions, name.Path) || slices.Contains(pol.Exceptions, name.String()) {
+		return errortypes.Undecided
+	}
+
+	if _, ok := tn.Type().Underlying().(*types.Struct); ok {
+		return pol.Struct
+	}
+
+	return pol.NonStruct
+}
+
+// String returns the requirements of the policy.
+func (pol Policy) String() string {
+	var parts []string
+
+	for _, r := range [...]struct {
+		name      string
+		errorType errortypes.ErrorType
+	}{
+		{"struct", pol.Struct},
+		{"nonstruct", pol.NonStruct},
+	} {
+		if r.errorType != errortypes.Undecided {
+			parts = append(parts, r.name+"="+strings.ToLower(r.errorType.String()))
+		}
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// processPolicy reports error types of the current package whose determined usage violates the policy,
+// or which have error assertion declarations (`var _ error = ...`) contradicting the policy.
+func (p pass) processPolicy(policy Policy) {
+	for tn, property := range p.AllSorted {
+		if tn.Pkg() != p.Pkg || tn.IsAlias() || p.inFile(tn.Pos()).inTest {
+			continue
+		}
+
+		required := policy.required(tn)
+		if required == errortypes.Undecided {
+			continue
+		}
+
+		kind := "struct"
+		if _, ok := tn.Type().Underlying().(*types.Struct); !ok {
+			kind = "non-struct"
+		}
+
+		assertions, mismatched := p.errorAssertions(tn, required)
+
+		var message string
+
+		switch determined := p.determinedType(property); {
+		case (determined == errortypes.PointerType || determined == errortypes.ValueType) && determined != required:
+			message = fmt.Sprintf("Error type %q is a %s type, but the policy requires %s error types to be %ss. (et:pol)",
+				tn.Name(), strings.ToLower(determined.String()), kind, strings.ToLower(required.String()))
+
+		case len(mismatched) > 0:
+			message = fmt.Sprintf("Error type %q is declared as a %s type by %d of %d error assertions, "+
+				"but the policy requires %s error types to be %ss. (et:pol)",
+				tn.Name(), strings.ToLower(opposite(required).String()), len(mismatched), len(assertions),
+				kind, strings.ToLower(required.String()))
+
+		default:
+			continue
+		}
+
+		diagnostic := analysis.Diagnostic{Pos: tn.Pos(), End: typeNameIdent{tn}.End(), Message: message}
+
+		if fix, ok := p.policyFix(tn, property, required, assertions, mismatched); ok {
+			diagnostic.SuggestedFixes = []analysis.SuggestedFix{fix}
+		}
+
+		p.Report(diagnostic)
+	}
+}
+
+// policyFix returns a suggested fix flipping the `var _ error` declarations of a type contradicting
+// the required usage, or inserting one when the usage is not declared otherwise.
+func (p pass) policyFix(tn *types.TypeName, property ErrorProperty, required errortypes.ErrorType,
+	assertions, mismatched []ast.Expr,
+) (analysis.SuggestedFix, bool) {
+	if required == errortypes.ValueType && property&PointerReceiver != 0 {
+		return analysis.SuggestedFix{}, false // Only usable as a pointer.
+	}
+
+	decl, ok := declaration(tn, required)
+	if !ok {
+		return analysis.SuggestedFix{}, false
+	}
+
+	if len(mismatched) > 0 {
+		newText := strings.TrimPrefix(decl, "var _ error = ")
+
+		edits := make([]analysis.TextEdit, 0, len(mismatched))
+		for _, value := range mismatched {
+			edits = append(edits, analysis.TextEdit{Pos: value.Pos(), End: value.End(), NewText: []byte(newText)})
+		}
+
+		return analysis.SuggestedFix{Message: "Change to `" + newText + "`", TextEdits: edits}, true
+	}
+
+	if len(assertions) > 0 || property&(PointerReceiver|OverrideMask|SuppressDirective|PointerDirective|ValueDirective) != 0 {
+		return analysis.SuggestedFix{}, false // The usage is declared otherwise.
+	}
+
+	fix, _, ok := p.declarationFix(tn, required)
+
+	return fix, ok
+}
+
+// errorAssertions finds the values of all error assertion declarations (`var _ error = ...`) for a type
+// in non-test files, and those among them not declaring the required usage.
+func (p pass) errorAssertions(tn *types.TypeName, required errortypes.ErrorType) (assertions, mismatched []ast.Expr) {
+	for varspec := range p.AllVarDecls {
+		if varspec.Type == nil || p.inFile(varspec.Pos()).inTest {
+			continue
+		}
+
+		if tv, ok := p.TypesInfo.Types[varspec.Type]; !ok || !typeutil.HasErrorMethod(tv.Type) {
+			continue
+		}
+
+		for _, value := range varspec.Values {
+			t, isPtr, ok := typeutil.TypeNameOf(p.TypesInfo.TypeOf(value))
+			if !ok || t != tn {
+				continue
+			}
+
+			assertions = append(assertions, value)
+
+			if isPtr != (required == errortypes.PointerType) {
+				mismatched = append(mismatched, value)
+			}
+		}
+	}
+
+	return assertions, mismatched
+}
+
+// opposite returns the other usage of a pointer or value error type.
+func opposite(errorType errortypes.ErrorType) errortypes.ErrorType {
+	if errorType == errortypes.PointerType {
+		return errortypes.ValueType
+	}
+
+	return errortypes.PointerType
+}
@@ -0,0 +1,258 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package detect
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"fillmore-labs.com/errortype/internal/errortypes"
+)
+
+// category is a named pair of properties indicating pointer or value usage of an error type.
+type category struct {
+	name       string
+	pair       [2]ErrorProperty
+	confidence errortypes.Confidence
+}
+
+const (
+	explicit  = errortypes.ExplicitConfidence
+	usage     = errortypes.UsageConfidence
+	heuristic = errortypes.HeuristicConfidence
+)
+
+// categories lists all categories of evidence that can be ranked.
+var categories = [...]category{
+	{"override", [2]ErrorProperty{PointerOverride, ValueOverride}, explicit},       // Explicit user override.
+	{"directive", [2]ErrorProperty{PointerDirective, ValueDirective}, explicit},    // `//errortype:` directives.
+	{"var", [2]ErrorProperty{PointerVar, ValueVar}, explicit},                      // Sentinel errors or `var _ error` assertions.
+	{"packagevar", [2]ErrorProperty{PointerPackageVar, ValuePackageVar}, explicit}, // Other package-level variables.
+	{"alias", [2]ErrorProperty{PointerAlias, ValueAlias}, explicit},                // Aliases of imported error types.
+	{"constructor", [2]ErrorProperty{PointerConstructor, ValueConstructor}, usage}, // Results of constructor functions.
+	{"return", [2]ErrorProperty{PointerReturn, ValueReturn}, usage},                // Usage in `return` statements.
+	{"assert", [2]ErrorProperty{PointerAssert, ValueAssert}, usage},                // Usage in type assertions.
+	{"target", [2]ErrorProperty{PointerTarget, ValueTarget}, usage},                // Usage in errors.As-like functions.
+	{"literal", [2]ErrorProperty{PointerLiteral, ValueLiteral}, usage},             // Usage as a composite literal.
+	{"cast", [2]ErrorProperty{PointerCast, ValueCast}, usage},                      // Usage in type casts.
+	{"doc", [2]ErrorProperty{PointerDoc, ValueDoc}, heuristic},                     // References in doc comments.
+	{"receivers", [2]ErrorProperty{PointerReceivers, ValueReceivers}, heuristic},   // Consistency of other method receivers.
+	{"test", [2]ErrorProperty{PointerTest, ValueTest}, heuristic},                  // Usage in test files.
+	{"vote", [2]ErrorProperty{PointerVote, ValueVote}, heuristic},                  // Module-wide usage vote.
+}
+
+// defaultPrecedence is the precedence used when none is configured.
+var defaultPrecedence = mustParsePrecedence(
+	"override", "var+directive", "packagevar", "alias", "constructor", "return", "assert", "target",
+	"literal", "cast", "doc", "receivers", "test", "vote",
+)
+
+// rank is a set of categories of equal precedence.
+type rank struct {
+	categories []category
+	pair       [2]ErrorProperty
+}
+
+// confidence returns the highest confidence of the categories in this rank with the given property.
+func (r rank) confidence(e ErrorProperty) errortypes.Confidence {
+	var confidence errortypes.Confidence
+
+	for _, c := range r.categories {
+		if e&(c.pair[0]|c.pair[1]) != 0 {
+			confidence = max(confidence, c.confidence)
+		}
+	}
+
+	return confidence
+}
+
+// Precedence defines the order of the categories of evidence used to determine if an error
+// type is a pointer or value type, from the strongest evidence to the weakest. The first rank
+// with a non-contradictory signal determines the type. Categories not ranked are ignored.
+type Precedence struct {
+	ranks []rank
+}
+
+// ParsePrecedence parses a list of ranks, ordered from the strongest to the weakest.
+// A rank is a category name like "return", or several categories of equal precedence
+// joined by "+", like "var+directive". Every category may only be ranked once.
+func ParsePrecedence(ranks ...string) (Precedence, error) {
+	var (
+		p    Precedence
+		seen = make(map[string]struct{}, len(categories))
+	)
+
+	for _, r := range ranks {
+		var rk rank
+
+		for name := range strings.SplitSeq(r, "+") {
+			name = strings.TrimSpace(name)
+
+			i := slices.IndexFunc(categories[:], func(c category) bool { return c.name == name })
+			if i < 0 {
+				return Precedence{}, fmt.Errorf("unknown evidence category %q", name) //nolint:err113
+			}
+
+			if _, ok := seen[name]; ok {
+				return Precedence{}, fmt.Errorf("evidence category %q ranked twice", name) //nolint:err113
+			}
+
+			seen[name] = struct{}{}
+
+			rk.categories = append(rk.categories, categories[i])
+			rk.pair[0] |= categories[i].pair[0]
+			rk.pair[1] |= categories[i].pair[1]
+		}
+
+		p.ranks = append(p.ranks, rk)
+	}
+
+	return p, nil
+}
+
+func mustParsePrecedence(ranks ...string) Precedence {
+	p, err := ParsePrecedence(ranks...)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
+
+// String returns the ranks of the precedence as a comma-separated list.
+func (p Precedence) String() string {
+	if len(p.ranks) == 0 {
+		return "none"
+	}
+
+	ranks := make([]string, 0, len(p.ranks))
+	for _, r := range p.ranks {
+		ranks = append(ranks, r.String())
+	}
+
+	return strings.Join(ranks, ",")
+}
+
+// String returns the names of the categories in this rank, joined by "+".
+func (r rank) String() string {
+	names := make([]string, 0, len(r.categories))
+	for _, c := range r.categories {
+		names = append(names, c.name)
+	}
+
+	return strings.Join(names, "+")
+}
+
+// TypeDefault represents a set of defaults for error types without decisive evidence.
+type TypeDefault uint8
+
+const (
+	// TypeDefaultNonStruct makes error types that are not structures or pointers default to value types.
+	TypeDefaultNonStruct TypeDefault = 1 << iota
+
+	// TypeDefaultErrorOnly makes error types whose only method is Error() default to the kind of its receiver.
+	TypeDefaultErrorOnly
+)
+
+// String returns the enabled defaults as a comma-separated list.
+func (d TypeDefault) String() string {
+	var parts []string
+
+	if d&TypeDefaultNonStruct != 0 {
+		parts = append(parts, "non-struct")
+	}
+
+	if d&TypeDefaultErrorOnly != 0 {
+		parts = append(parts, "error-only")
+	}
+
+	if len(parts) == 0 {
+		return "off"
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// ladder combines the precedence of evidence with the defaults used when no evidence is decisive.
+type ladder struct {
+	precedence Precedence
+	defaults   TypeDefault
+}
+
+// defaultLadder is the ladder used when nothing is configured.
+var defaultLadder = ladder{precedence: defaultPrecedence, defaults: 0}
+
+// String returns a description of the ladder.
+func (l ladder) String() string {
+	return fmt.Sprintf("precedence %s; defaults %s", l.precedence, l.defaults)
+}
+
+// determinedType checks if the collected properties unambiguously determine
+// whether the type should be a pointer or a value error type.
+func (l ladder) determinedType(e ErrorProperty) errortypes.ErrorType {
+	return l.decide(e).ErrorType
+}
+
+// decide determines whether the type should be a pointer or a value error type,
+// together with the confidence derived from the evidence that decided it.
+//
+// Contradictory properties (e.g., both PointerVar and ValueVar being set)
+// for a given rank are ignored, and the decision moves to the next rank.
+func (l ladder) decide(e ErrorProperty) errortypes.Determined {
+	if e&SuppressOverride != 0 { // Suppression override has the highest precedence.
+		return errortypes.Determined{ErrorType: errortypes.SuppressType, Confidence: explicit}
+	}
+
+	if e&SuppressDirective != 0 && e&(PointerOverride|ValueOverride) == 0 { // Suppression directive, unless overridden.
+		return errortypes.Determined{ErrorType: errortypes.SuppressType, Confidence: explicit}
+	}
+
+	if e&PointerReceiver != 0 { // Errors with pointer receivers can only be used in only one way.
+		return errortypes.Determined{ErrorType: errortypes.PointerType, Confidence: explicit}
+	}
+
+	for _, r := range l.precedence.ranks {
+		pointer, value := e&r.pair[0], e&r.pair[1]
+		switch { // Check for a non-contradictory usage within this rank.
+		case pointer != 0 && value == 0:
+			return errortypes.Determined{ErrorType: errortypes.PointerType, Confidence: r.confidence(pointer)}
+
+		case value != 0 && pointer == 0:
+			return errortypes.Determined{ErrorType: errortypes.ValueType, Confidence: r.confidence(value)}
+		}
+	}
+
+	// A special case for defined pointer types like `type T *S`.
+	// Although the underlying type is a pointer, `T` itself is used as a value
+	// (e.g., you return `T`, not `*T`), so we treat it as a value type.
+	if e&PointerDef != 0 {
+		return errortypes.Determined{ErrorType: errortypes.ValueType, Confidence: explicit}
+	}
+
+	if l.defaults&TypeDefaultNonStruct != 0 && e&NonStruct != 0 {
+		return errortypes.Determined{ErrorType: errortypes.ValueType, Confidence: heuristic}
+	}
+
+	// Types with a pointer receiver Error() method are always pointer types, so only value receivers are left.
+	if l.defaults&TypeDefaultErrorOnly != 0 && e&ErrorOnly != 0 {
+		return errortypes.Determined{ErrorType: errortypes.ValueType, Confidence: heuristic}
+	}
+
+	// No unambiguous usage was found.
+	return errortypes.Determined{ErrorType: errortypes.Undecided, Confidence: heuristic}
+}
@@ -25,7 +25,7 @@ import (
 
 func (p pass) processReceivers() {
 	for tn, errorType := range p.PropertyMap {
-		if errorType.DeterminedType() != errortypes.Undecided {
+		if p.determinedType(errorType) != errortypes.Undecided {
 			continue
 		}
 
@@ -27,8 +27,10 @@ import (
 // ResultInfo holds the determined pointer-ness for a type,
 // identified by its *types.TypeName.
 type ResultInfo struct {
-	TypeName  *types.TypeName
-	ErrorType errortypes.ErrorType
+	TypeName   *types.TypeName
+	ErrorType  errortypes.ErrorType
+	Confidence errortypes.Confidence // How firmly the usage is established.
+	Vote       bool                  // The usage was determined by a module-wide vote.
 }
 
 // Result is the result of the detecttypes analyzer. It contains a list of all
@@ -38,44 +40,65 @@ type Result struct {
 }
 
 // createResult combines all determined type information into the final analyzer result.
-// It merges types from the current package and dependencies (facts) and local overrides,
-// with local overrides having the highest precedence.
-func (p pass) createResult() Result {
+// It merges types from the current package and dependencies (facts), local overrides, and
+// scoped overrides, with scoped overrides having the highest precedence.
+func (p pass) createResult(vote *Vote, scoped map[*types.TypeName]errortypes.Determined) Result {
 	facts := p.AllObjectFacts()
 
 	// Add types from dependencies (via facts).
 	determinedTypes := extractErrorTypes(facts)
 
+	// Types from dependencies have been decided by the vote when they have the voted usage, since
+	// votes only decide otherwise undetermined types and only differing usages are voted on.
+	voted := make(map[*types.TypeName]bool)
+	for tn, determined := range determinedTypes {
+		if v, ok := vote.lookup(tn); ok && v == determined.ErrorType {
+			voted[tn] = true
+		}
+	}
+
 	// Iterate over all types in the current package whose pointer-ness has been determined.
-	for tn, errorType := range p.AllDetermined {
+	for tn, determined := range p.AllDetermined {
 		if tn.Pkg() == p.Pkg {
 			// Export this information as a fact when the type is defined in the current package.
 			// These facts can then be consumed by analyzers running on packages dependent on this one.
-			p.ExportObjectFact(tn, &errorType)
+			p.ExportObjectFact(tn, &determined)
 		}
 
 		// Add type to result.
 		// Local overrides will overwrite any existing entries from facts, only for this package.
-		determinedTypes[tn] = errorType
+		determinedTypes[tn] = determined
+		voted[tn] = p.determinedType(p.PropertyMap[tn]&^VoteMask) == errortypes.Undecided
+	}
+
+	// Scoped overrides have the highest precedence, only for this package.
+	for tn, determined := range scoped {
+		determinedTypes[tn] = determined
+		voted[tn] = false
 	}
 
 	// Convert map to slice for the result.
-	return createResult(determinedTypes)
+	return createResult(determinedTypes, voted)
 }
 
-func createResult(determinedTypes map[*types.TypeName]errortypes.ErrorType) Result {
+func createResult(determinedTypes map[*types.TypeName]errortypes.Determined, voted map[*types.TypeName]bool) Result {
 	typs := make([]ResultInfo, 0, len(determinedTypes))
-	for tn, errorType := range determinedTypes {
-		typs = append(typs, ResultInfo{TypeName: tn, ErrorType: errorType})
+	for tn, determined := range determinedTypes {
+		typs = append(typs, ResultInfo{
+			TypeName:   tn,
+			ErrorType:  determined.ErrorType,
+			Confidence: determined.Confidence,
+			Vote:       voted[tn],
+		})
 	}
 
 	return Result{Types: typs}
 }
 
-func extractErrorTypes(facts []analysis.ObjectFact) map[*types.TypeName]errortypes.ErrorType {
-	determinedTypes := make(map[*types.TypeName]errortypes.ErrorType, len(facts))
+func extractErrorTypes(facts []analysis.ObjectFact) map[*types.TypeName]errortypes.Determined {
+	determinedTypes := make(map[*types.TypeName]errortypes.Determined, len(facts))
 	for _, f := range facts {
-		fact, ok := f.Fact.(*errortypes.ErrorType)
+		fact, ok := f.Fact.(*errortypes.Determined)
 		if !ok {
 			continue
 		}
@@ -16,7 +16,17 @@
 
 package detect
 
-import "golang.org/x/tools/go/analysis"
+import (
+	"errors"
+	"log"
+
+	"golang.org/x/tools/go/analysis"
+
+	"fillmore-labs.com/errortype/internal/flow"
+)
+
+// ErrNoSSAResult is returned when the result from the SSA analyzer is missing.
+var ErrNoSSAResult = errors.New("detecttypes: SSA result missing")
 
 // run is the main function for the detecttypes analyzer.
 //
@@ -27,21 +37,61 @@ import "golang.org/x/tools/go/analysis"
 // It then exports the determined properties as facts for downstream packages and
 // returns a result containing all relevant properties for the current analysis pass.
 func (o *options) run(ap *analysis.Pass) (any, error) {
-	p := newPass(ap)
+	ssaResult, ok := ap.ResultOf[flow.Analyzer].(*flow.SSA)
+	if !ok {
+		return nil, ErrNoSSAResult
+	}
+
+	if err := o.checkOverrides(); err != nil {
+		return nil, err
+	}
+
+	if o.debug {
+		o.logLadder.Do(func() { log.Printf("Evidence ladder: %s", o.ladder) })
+	}
+
+	o.registerOverrides.Do(func() {
+		o.pins.register(o.usageOverrides)
+
+		sets := []overrideSet{o.usageOverrides}
+		for _, s := range o.scopes {
+			sets = append(sets, s.overrides)
+		}
+
+		o.overrideUsage.register(sets...)
+	})
+
+	p := newPass(ap, o.ladder)
 
 	// Process type declarations in the current package.
 	p.processTypeDecls()
 
+	// Process directives on type declarations in the current package.
+	p.processDirectives()
+
 	// Process variable declarations, identifying properties for both local and external types.
 	// External type properties are considered local overrides.
-	p.processVarSpecs()
+	p.processVarSpecs(o.sentinels)
 
 	// Calculate overrides and log impossible ones.
-	p.processOverrides(o.usageOverrides)
+	local := p.processOverrides(o.usageOverrides, o.pins, &o.overrideConflicts, o.debug)
+
+	if o.heuristics&HeuristicConstructors != 0 && p.HasUndeterminedErrors() {
+		// Process constructor functions in the current package.
+		p.processConstructors()
+	}
 
 	if o.heuristics&HeuristicUsage != 0 && p.HasUndeterminedErrors() {
 		// Process error value usage in the current package.
 		p.processUsage()
+
+		// Follow error values through local variables.
+		p.processFlows(ssaResult.SrcFuncs)
+	}
+
+	if o.heuristics&HeuristicDocs != 0 && p.HasUndeterminedErrors() {
+		// Process references in doc comments.
+		p.processDocs()
 	}
 
 	if o.heuristics&HeuristicReceivers != 0 && p.HasUndeterminedErrors() {
@@ -49,9 +99,30 @@ func (o *options) run(ap *analysis.Pass) (any, error) {
 		p.processReceivers()
 	}
 
+	if o.vote != nil && p.HasUndeterminedErrors() {
+		// Decide remaining types by the module-wide vote.
+		p.processVote(o.vote)
+	}
+
 	// Process alias declarations in the current package.
 	p.processAliases()
 
+	// Report contradictory evidence for types in the current package.
+	p.processContradictions()
+
+	if o.policy.enabled() {
+		// Report types in the current package violating the policy.
+		p.processPolicy(o.policy)
+	}
+
+	if o.requireDecl {
+		// Report exported types in the current package without explicit declarations.
+		p.processDeclarations()
+	}
+
+	// Record how overrides applied to types in the current package.
+	p.recordOverrides(local, o.overrideUsage)
+
 	if o.debug {
 		p.logResults()
 	}
@@ -59,7 +130,7 @@ func (o *options) run(ap *analysis.Pass) (any, error) {
 	// Export determined properties for types in the current package as facts for downstream packages.
 	// Create and return a result containing all determined properties for the current analysis pass,
 	// including those from dependencies (facts), the current package, and local overrides.
-	result := p.createResult()
+	result := p.createResult(o.vote, p.processScopes(o.scopes, o.overrideUsage, o.debug))
 
 	return result, nil
 }
@@ -0,0 +1,149 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package detect
+
+import (
+	"go/ast"
+	"go/types"
+	"log"
+	"regexp"
+	"slices"
+
+	"fillmore-labs.com/errortype/internal/errortypes"
+	"fillmore-labs.com/errortype/internal/overrides"
+	"fillmore-labs.com/errortype/internal/typeutil"
+)
+
+// scope holds overrides applying only to consuming packages matching one of its package patterns.
+type scope struct {
+	packages  []*regexp.Regexp
+	overrides overrideSet
+}
+
+// newScope compiles the package patterns of a scope. Like package patterns of the go command,
+// a trailing "/..." also matches the package itself.
+func newScope(sc overrides.Scope) scope {
+	var s scope
+
+	for _, pkg := range sc.Packages {
+		re, _ := compileGlob(pkg, `(/.*)?`)
+		s.packages = append(s.packages, re)
+	}
+
+	s.overrides.add(sc.Overrides)
+
+	return s
+}
+
+// applies reports whether the scope applies to the package with the given path.
+func (s scope) applies(path string) bool {
+	return slices.ContainsFunc(s.packages, func(re *regexp.Regexp) bool { return re.MatchString(path) })
+}
+
+func (o *options) addScope(sc overrides.Scope) {
+	o.scopes = append(o.scopes, newScope(sc))
+}
+
+// processScopes determines the usage of types referenced in the current package by the overrides
+// of the scopes applying to it. Later scopes take precedence over earlier ones. The result is only used for the
+// current package and does not affect exported facts.
+func (p pass) processScopes(scopes []scope, usage *OverrideUsage, debug bool) map[*types.TypeName]errortypes.Determined {
+	path := p.Pkg.Path()
+
+	var applying []scope
+
+	for _, s := range scopes {
+		if s.applies(path) {
+			applying = append(applying, s)
+		}
+	}
+
+	if len(applying) == 0 {
+		return nil
+	}
+
+	scoped := make(map[*types.TypeName]errortypes.Determined)
+
+	for tn := range p.referencedTypeNames() {
+		typeName := typeutil.NewTypeName(tn)
+
+		for _, s := range slices.Backward(applying) {
+			match, ok := s.overrides.lookup(typeName, p.moduleVersion(tn), nil)
+			if !ok {
+				continue
+			}
+
+			errorType := match.errorType
+			if !implementsUsage(tn, errorType) {
+				switch {
+				case usage != nil:
+					usage.record(match.override, func(r *overrideRecord) {
+						r.impossible = append(r.impossible, usageTypeString(typeName, errorType))
+					})
+
+				case match.pattern == "":
+					log.Printf("%s override \"%s\" in scope does not implement the error interface",
+						errorType, usageTypeString(typeName, errorType))
+				}
+
+				continue
+			}
+
+			if debug {
+				log.Printf("Scoped override applies %s to %s in %s", errorType, typeName, path)
+			}
+
+			usage.record(match.override, func(r *overrideRecord) { r.effective = true })
+
+			scoped[tn] = errortypes.Determined{ErrorType: errorType, Confidence: errortypes.ExplicitConfidence}
+
+			break
+		}
+	}
+
+	return scoped
+}
+
+// referencedTypeNames yields the named types defined or used in the current package.
+func (p pass) referencedTypeNames() func(yield func(*types.TypeName) bool) {
+	return func(yield func(*types.TypeName) bool) {
+		seen := make(map[*types.TypeName]struct{})
+
+		for _, objs := range [...]map[*ast.Ident]types.Object{p.TypesInfo.Defs, p.TypesInfo.Uses} {
+			for _, obj := range objs {
+				tn, ok := obj.(*types.TypeName)
+				if !ok || tn.IsAlias() {
+					continue
+				}
+
+				if _, ok := tn.Type().(*types.Named); !ok {
+					continue
+				}
+
+				if _, ok := seen[tn]; ok {
+					continue
+				}
+
+				seen[tn] = struct{}{}
+
+				if !yield(tn) {
+					return
+				}
+			}
+		}
+	}
+}
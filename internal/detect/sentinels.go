@@ -0,0 +1,58 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package detect
+
+import (
+	"go/ast"
+	"go/types"
+	"regexp"
+)
+
+// defaultSentinelPattern matches the names of sentinel errors by convention, like `ErrNotFound` or `errClosed`.
+var defaultSentinelPattern = regexp.MustCompile(`^[Ee]rr`)
+
+// sentinelBlockPattern matches doc comments of `var ( ... )` blocks declaring sentinel errors,
+// like "Sentinel errors returned by this package.", but not "These are not sentinel errors.".
+var sentinelBlockPattern = regexp.MustCompile(`(?i)^sentinels?\b`)
+
+// sentinels configures which package-level variables are considered declarations of error types.
+type sentinels struct {
+	// patterns match the names of sentinel errors.
+	patterns []*regexp.Regexp
+
+	// packageVars records other package-level variables of concrete error types, too.
+	packageVars bool
+}
+
+// matches reports whether the variable with the given name in pkg is a sentinel error.
+// Patterns are matched against the variable name and its qualified name, like `example.com/errs.NotFound`.
+func (s sentinels) matches(pkg *types.Package, name string) bool {
+	qualified := pkg.Path() + "." + name
+
+	for _, pattern := range s.patterns {
+		if pattern.MatchString(name) || pattern.MatchString(qualified) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSentinelBlock reports whether g is a grouped variable declaration documented as sentinel errors.
+func isSentinelBlock(g *ast.GenDecl) bool {
+	return g.Lparen.IsValid() && g.Doc != nil && sentinelBlockPattern.MatchString(g.Doc.Text())
+}
@@ -0,0 +1,51 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package c
+
+type (
+	CtorPointer struct{ error }
+	CtorValue   struct{ error }
+	CtorResult  struct{ error }
+	CtorIgnored struct{ error }
+	CtorNewline struct{ error }
+)
+
+func NewCtorPointer() error { return &CtorPointer{} } // pointer type, constructor
+
+func WrapCtorPointer() error { return CtorPointer{} } // overwritten by constructor
+
+func newCtorValue() error { return CtorValue{} } // value type, constructor
+
+func WrapCtorValue() error { return &CtorValue{} } // overwritten by constructor
+
+func NewCtorResult() CtorResult { return CtorResult{} } // value result type
+
+func WrapCtorResult() error { return &CtorResult{} } // overwritten by constructor
+
+func NewCtorIgnored() error {
+	f := func() error { return CtorIgnored{} } // not returned by the constructor
+
+	return f()
+}
+
+func WrapCtorIgnored() error { return &CtorIgnored{} } // contradictory, ignored
+
+func Newline() error { return CtorNewline{} } // value type, not a constructor
+
+func WrapCtorNewline() error { return &CtorNewline{} } // contradictory, ignored
+
+var _ = newCtorValue
@@ -0,0 +1,41 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package c
+
+type (
+	NamedPointer struct{ error }
+	NamedValue   struct{ error }
+	NamedFirst   struct{ error }
+)
+
+func NewNamedPointer() (err error) {
+	defer func() {
+		err = &NamedPointer{} // pointer type, assigned to a named result
+	}()
+
+	return
+}
+
+func NewNamedValue() (_ int, err error) {
+	err = NamedValue{} // value type, assigned to a named result
+
+	return
+}
+
+func NewNamedFirst() (error, int) {
+	return &NamedFirst{}, 0 // pointer type, error result is not last
+}
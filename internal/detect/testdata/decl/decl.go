@@ -0,0 +1,41 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package decl
+
+type DeclPointer struct{ error } // want DeclPointer:"^Pointer \\(usage\\)$" "Exported error type \"DeclPointer\" does not declare its usage explicitly. Add `var _ error = \\(\\*DeclPointer\\)\\(nil\\)`. \\(et:decl\\)$"
+
+type (
+	DeclValue string // want DeclValue:"^Value \\(heuristic\\)$" "Exported error type \"DeclValue\" does not declare its usage explicitly. Add `var _ error = DeclValue\\(\"\"\\)`. \\(et:decl\\)$"
+
+	DeclUndetermined struct{ error } // want "Exported error type \"DeclUndetermined\" does not declare its usage explicitly. Add a `var _ error` declaration or a directive. \\(et:decl\\)$"
+)
+
+func (e DeclValue) Error() string { return string(e) }
+
+type DeclVar struct{ error } // want DeclVar:"^Value \\(explicit\\)$"
+
+var _ error = DeclVar{}
+
+type DeclReceiver struct{} // want DeclReceiver:"^Pointer \\(explicit\\)$"
+
+func (*DeclReceiver) Error() string { return "receiver" }
+
+type declUnexported struct{ error } // want declUnexported:"^Pointer \\(usage\\)$"
+
+func NewDeclPointer() error { return &DeclPointer{} }
+
+func newDeclUnexported() error { return &declUnexported{} }
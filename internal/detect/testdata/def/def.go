@@ -0,0 +1,53 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package def
+
+import "errors"
+
+type (
+	DefDecided struct{ error } // want DefDecided:"^Value \\(usage\\)$" "Contradictory evidence for error type \"DefDecided\": return: pointer at def.go:30, value at def.go:34. Usage decided as value by \"assert\". \\(et:def\\)$"
+	DefClean   struct{ error } // want DefClean:"^Pointer \\(usage\\)$"
+)
+
+//errortype:value
+type DefVar struct{ error } // want DefVar:"^Pointer \\(usage\\)$" "Contradictory evidence for error type \"DefVar\": var\\+directive: pointer at def.go:37, value at def.go:26. Usage decided as pointer by \"constructor\". \\(et:def\\)$"
+
+func ReturnPointer() error {
+	return &DefDecided{}
+}
+
+func ReturnValue() error {
+	return DefDecided{}
+}
+
+var ErrDefVar = &DefVar{}
+
+func NewDefVar() error { return &DefVar{} }
+
+func IsDefDecided(err error) bool {
+	_, ok := err.(DefDecided)
+
+	return ok
+}
+
+func NewDefClean() error { return &DefClean{} }
+
+func IsDefClean(err error) bool {
+	var target *DefClean
+
+	return errors.As(err, &target)
+}
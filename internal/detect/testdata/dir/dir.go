@@ -0,0 +1,56 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dir
+
+//errortype:pointer
+type DirPointer struct{ error } // want DirPointer:"^Pointer \\(explicit\\)$"
+
+//errortype:value
+type DirValue struct{ error } // want DirValue:"^Value \\(explicit\\)$"
+
+type (
+	//errortype:suppress
+	DirSuppress struct{ error } // want DirSuppress:"^Suppress \\(explicit\\)$"
+
+	DirComment struct{ error } //errortype:value // want DirComment:"^Value \\(explicit\\)$"
+
+	//errortype:pointer
+	DirVar struct{ error } // want DirVar:"^Pointer \\(explicit\\)$"
+
+	//errortype:value
+	//errortype:pointer // want "conflicts with"
+	DirConflict struct{ error }
+
+	//errortype:value // want "is invalid"
+	DirInvalid struct{} // want DirInvalid:"^Pointer \\(explicit\\)$"
+
+	//errortype:unknown // want "Unknown directive"
+	DirUnknown struct{ error }
+
+	//errortype:pointer // want "must be placed on an error type declaration"
+	DirNoError struct{}
+)
+
+//errortype:value // want "must be placed on an error type declaration"
+type DirAlias = DirValue // want DirAlias:"^Value \\(explicit\\)$"
+
+func (*DirInvalid) Error() string { return "" }
+
+func NewDirVar() error { return DirVar{} } // overwritten by directive
+
+//errortype:value // want "must be placed on an error type declaration"
+func F() {}
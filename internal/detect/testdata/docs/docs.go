@@ -0,0 +1,43 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package docs
+
+type (
+	// DocPointer is returned as a pointer.
+	DocPointer struct{ error } // want DocPointer:"^Pointer \\(heuristic\\)$"
+
+	// A DocValue is returned as a value.
+	DocValue struct{ error } // want DocValue:"^Value \\(heuristic\\)$"
+
+	// DocLink is returned as [*DocLink].
+	DocLink struct{ error } // want DocLink:"^Pointer \\(heuristic\\)$"
+
+	// DocMentioned is only mentioned, DocMentioned.
+	DocMentioned struct{ error }
+
+	// DocContradictory is returned as a value.
+	DocContradictory struct{ error } // want "Contradictory evidence for error type \"DocContradictory\": doc: pointer at docs.go:42, value at docs.go:42. Usage remains undetermined. \\(et:def\\)$"
+)
+
+// Parse returns a *DocPointer on failure.
+func Parse() error { return nil }
+
+// Check returns an error. The error will be of type DocValue.
+func Check() error { return nil }
+
+// Validate may return a DocContradictory, or a *DocContradictory.
+func Validate() error { return nil }
@@ -3,3 +3,7 @@ module test
 go 1.24.0
 
 toolchain go1.25.0
+
+require example.com/pinlib v1.4.0
+
+replace example.com/pinlib v1.4.0 => ./pinlib
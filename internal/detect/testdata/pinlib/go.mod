@@ -0,0 +1,3 @@
+module example.com/pinlib
+
+go 1.24.0
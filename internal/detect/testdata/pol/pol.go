@@ -0,0 +1,45 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pol
+
+type PolVar struct{ error } // want PolVar:"^Value \\(explicit\\)$" "Error type \"PolVar\" is a value type, but the policy requires struct error types to be pointers. \\(et:pol\\)$"
+
+var _ error = PolVar{}
+
+type PolUsage struct{ error } // want PolUsage:"^Value \\(usage\\)$" "Error type \"PolUsage\" is a value type, but the policy requires struct error types to be pointers. \\(et:pol\\)$"
+
+func NewPolUsage() error { return PolUsage{} }
+
+type PolReceiver int // want PolReceiver:"^Pointer \\(explicit\\)$" "Error type \"PolReceiver\" is a pointer type, but the policy requires non-struct error types to be values. \\(et:pol\\)$"
+
+func (*PolReceiver) Error() string { return "receiver" }
+
+type PolPointer struct{ error } // want PolPointer:"^Pointer \\(explicit\\)$"
+
+var _ error = (*PolPointer)(nil)
+
+type PolException struct{ error } // want PolException:"^Value \\(explicit\\)$"
+
+var _ error = PolException{}
+
+type PolConflict struct{ error } // want "Contradictory evidence for error type \"PolConflict\": .* \\(et:def\\)$" "Error type \"PolConflict\" is declared as a value type by 2 of 3 error assertions, but the policy requires struct error types to be pointers. \\(et:pol\\)$"
+
+var (
+	_ error = PolConflict{}
+	_ error = (*PolConflict)(nil)
+	_ error = PolConflict{}
+)
@@ -0,0 +1,48 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package prec
+
+type PrecNonStruct string // want PrecNonStruct:"^Value \\(heuristic\\)$"
+
+func (e PrecNonStruct) Error() string { return string(e) }
+
+func (e *PrecNonStruct) Set(msg string) { *e = PrecNonStruct(msg) }
+
+type PrecErrorOnly struct{ msg string } // want PrecErrorOnly:"^Value \\(heuristic\\)$"
+
+func (e PrecErrorOnly) Error() string { return e.msg }
+
+type PrecMethods struct{ msg string }
+
+func (e PrecMethods) Error() string { return e.msg }
+
+func (e PrecMethods) Unwrap() error { return nil }
+
+type (
+	PrecOrder     struct{ error } // want PrecOrder:"^Value \\(usage\\)$"
+	PrecReceivers struct{ error }
+)
+
+func (PrecReceivers) Temporary() bool { return true }
+
+func NewPrecOrder() error { return &PrecOrder{} } // pointer type
+
+func IsPrecOrder(err error) bool {
+	_, ok := err.(PrecOrder) // value type
+
+	return ok
+}
@@ -0,0 +1,49 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sent
+
+type (
+	SentNotFound struct{ error } // want SentNotFound:"^Pointer \\(explicit\\)$"
+	SentPackage  struct{ error } // want SentPackage:"^Value \\(explicit\\)$"
+	SentOrder    struct{ error } // want SentOrder:"^Pointer \\(explicit\\)$"
+	SentBlock    struct{ error } // want SentBlock:"^Value \\(explicit\\)$"
+	SentNotBlock struct{ error } // want SentNotBlock:"^Pointer \\(explicit\\)$"
+)
+
+var NotFound = &SentNotFound{}
+
+// Other package variables.
+var (
+	current = SentPackage{}
+	other   = SentOrder{}
+)
+
+var ErrOrder = &SentOrder{}
+
+// Sentinel errors.
+var (
+	Missing = SentBlock{}
+)
+
+var blockOther = &SentBlock{}
+
+// These are not sentinel errors.
+var (
+	notBlock = SentNotBlock{}
+)
+
+var ErrNotBlock = &SentNotBlock{}
@@ -0,0 +1,33 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tst
+
+type (
+	TstReturn struct{ error } // want TstReturn:"^Pointer \\(usage\\)$"
+	TstAssert struct{ error } // want TstAssert:"^Value \\(usage\\)$"
+	TstVar    struct{ error } // want TstVar:"^Pointer \\(usage\\)$"
+)
+
+func NewTstReturn() error { return &TstReturn{} } // pointer type
+
+func newTstVar() error { return &TstVar{} } // pointer type
+
+func IsTstAssert(err error) bool {
+	_, ok := err.(TstAssert) // value type
+
+	return ok
+}
@@ -82,8 +82,19 @@ func (p pass) processTypeDecls() {
 			if nonstruct {
 				prop = NonStruct
 			}
+
+			if errorOnly(tn) {
+				prop |= ErrorOnly
+			}
 		}
 
 		p.AddTypeProperty(tn, prop)
 	}
 }
+
+// errorOnly checks whether the only method declared on the type is Error().
+func errorOnly(tn *types.TypeName) bool {
+	named, ok := tn.Type().(*types.Named)
+
+	return ok && named.NumMethods() == 1 && named.Method(0).Name() == "Error"
+}
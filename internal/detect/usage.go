@@ -18,6 +18,7 @@ package detect
 
 import (
 	"go/ast"
+	"go/token"
 	"go/types"
 
 	"fillmore-labs.com/errortype/internal/typeutil"
@@ -26,22 +27,32 @@ import (
 // processUsage processes all function declarations in the current package,
 // visiting their bodies to perform error usage analysis.
 func (p pass) processUsage() {
-	u := usageVisitor{pass: p}
-
 	for f := range p.AllFuncDecls {
 		if f.Body == nil {
 			continue
 		}
 
-		u.lastResult = typeutil.HasErrorResult(p.TypesInfo, f.Type.Results)
-
-		ast.Walk(u, f.Body)
+		ast.Walk(p.inFile(f.Pos()).newUsageVisitor(f.Type), f.Body)
 	}
 }
 
 type usageVisitor struct {
 	pass
-	lastResult int
+	results    []typeutil.ErrorResult
+	numResults int
+}
+
+// newUsageVisitor creates a usageVisitor for the body of a function with the given type,
+// recording its named error results.
+func (p pass) newUsageVisitor(ft *ast.FuncType) usageVisitor {
+	results := typeutil.ErrorResults(p.TypesInfo, ft.Results)
+	for _, result := range results {
+		if result.Var != nil {
+			p.results[result.Var] = struct{}{}
+		}
+	}
+
+	return usageVisitor{pass: p, results: results, numResults: ft.Results.NumFields()}
 }
 
 func (v usageVisitor) Visit(node ast.Node) ast.Visitor {
@@ -50,6 +61,9 @@ func (v usageVisitor) Visit(node ast.Node) ast.Visitor {
 		// Analyze the right-hand side of `:=` and `=` assignments.
 		v.walkExprs(n.Rhs)
 
+		// Assignments to named error results are returned, too.
+		v.handleResultAssign(n)
+
 		return nil // Expressions handled, stop descending.
 
 	case *ast.ValueSpec:
@@ -73,12 +87,7 @@ func (v usageVisitor) Visit(node ast.Node) ast.Visitor {
 	case *ast.FuncLit:
 		// A function literal defines a new function.
 		// We inspect its body for how it returns error types.
-		u := usageVisitor{
-			pass:       v.pass,
-			lastResult: typeutil.HasErrorResult(v.TypesInfo, n.Type.Results),
-		}
-
-		ast.Walk(u, n.Body)
+		ast.Walk(v.newUsageVisitor(n.Type), n.Body)
 
 		return nil // Expression handled, stop descending.
 
@@ -105,15 +114,28 @@ func (v usageVisitor) Visit(node ast.Node) ast.Visitor {
 func (v usageVisitor) handleReturn(ret *ast.ReturnStmt) {
 	v.walkExprs(ret.Results)
 
-	if v.lastResult < 0 || len(ret.Results) <= v.lastResult {
-		return
+	if len(ret.Results) != v.numResults {
+		return // Naked return or multi-value function call.
 	}
 
-	res := ret.Results[v.lastResult]
+	for _, result := range v.results {
+		v.handleResult(ret.Results[result.Index])
+	}
+}
+
+// handleResultAssign processes assignments to named error results, which are returned
+// like the results of a return statement.
+func (p pass) handleResultAssign(n *ast.AssignStmt) {
+	for _, res := range typeutil.AssignedErrorResults(p.TypesInfo, n, p.results) {
+		p.handleResult(res)
+	}
+}
 
-	resType := v.TypesInfo.Types[res]
+// handleResult processes a returned error value.
+func (p pass) handleResult(res ast.Expr) {
+	resType := p.TypesInfo.Types[res]
 	if !resType.IsValue() { // should not happen
-		v.LogErrorf(res, "Expected value, got %#v", resType)
+		p.LogErrorf(res, "Expected value, got %#v", resType)
 	}
 
 	if resType.IsNil() {
@@ -130,21 +152,16 @@ func (v usageVisitor) handleReturn(ret *ast.ReturnStmt) {
 		property = PointerReturn
 	}
 
-	v.addTypePropertyInCurrentPackage(tn, property)
+	p.addTypePropertyInCurrentPackage(tn, property, res.Pos())
 }
 
 func (p pass) handleCallExpr(n *ast.CallExpr) {
-	_, _, targetArgIndex := typeutil.IsErrorAs(p.TypesInfo, n)
+	_, _, targetArgIndex := p.funcValues.IsErrorAs(p.TypesInfo, n)
 	if targetArgIndex < 0 { // not an errors.As-like function
 		p.walkExprs(n.Args)
 
 		if f, ok := n.Fun.(*ast.FuncLit); ok { // For immediately invoked function literals, examine their body.
-			u := usageVisitor{
-				pass:       p,
-				lastResult: typeutil.HasErrorResult(p.TypesInfo, f.Type.Results),
-			}
-
-			ast.Walk(u, f.Body)
+			ast.Walk(p.newUsageVisitor(f.Type), f.Body)
 		}
 
 		return
@@ -176,7 +193,7 @@ func (p pass) handleCallExpr(n *ast.CallExpr) {
 		property = PointerTarget
 	}
 
-	p.addTypePropertyInCurrentPackage(tn, property)
+	p.addTypePropertyInCurrentPackage(tn, property, targetArg.Pos())
 }
 
 // walkExprs applies the assignVisitor to each expression in the given list.
@@ -189,12 +206,20 @@ func (p pass) walkExprs(exprs []ast.Expr) {
 }
 
 // addTypePropertyInCurrentPackage sets a property on a type if it's a known error type
-// in the current package and the property isn't yet set.
-func (p pass) addTypePropertyInCurrentPackage(tn *types.TypeName, property ErrorProperty) {
+// in the current package and the property isn't yet set. The position is recorded as
+// a site of the property.
+//
+// Usage in test files is recorded as [PointerTest] or [ValueTest], so it only decides
+// when there is no other evidence.
+func (p pass) addTypePropertyInCurrentPackage(tn *types.TypeName, property ErrorProperty, pos token.Pos) {
 	if tn.Pkg() != p.Pkg {
 		return // Only relevant for types defined in the current package
 	}
 
+	if p.inTest {
+		property = property.testProperty()
+	}
+
 	old, ok := p.GetTypeProperty(tn)
 	if !ok {
 		return // Not a known error type
@@ -203,4 +228,6 @@ func (p pass) addTypePropertyInCurrentPackage(tn *types.TypeName, property Error
 	if old&property == 0 { // property isn't set.
 		p.SetTypeProperty(tn, old|property)
 	}
+
+	p.sites.add(tn, property, pos)
 }
@@ -18,8 +18,8 @@ package detect
 
 import (
 	"go/ast"
+	"go/token"
 	"go/types"
-	"strings"
 
 	"fillmore-labs.com/errortype/internal/typeutil"
 )
@@ -33,43 +33,63 @@ import (
 //
 //  2. Sentinel Errors: A declaration like `var ErrSomething = &T{}` indicates
 //     a pointer error, while `var ErrSomething = T{}` indicates a value error.
+//     Sentinel errors are recognized by their names matching the sentinel patterns,
+//     or by being declared in a `var ( ... )` block documented as sentinel errors.
+//     Optionally, other package-level variables are recorded with a weaker property.
 //
 // Discovered properties are recorded for analysis. If the type `T` is defined
 // in the current package, this property is exported as a fact. If `T` is from
 // an external package, the property is treated as a local override.
-func (p pass) processVarSpecs() {
-	for varspec := range p.AllVarDecls {
-		// Handle sentinel errors, e.g., `var ErrSomething = ...` where the type is inferred.
-		if varspec.Type == nil {
-			p.findSentinelErrors(varspec)
-
+func (p pass) processVarSpecs(s sentinels) {
+	for g := range allDecls[*ast.GenDecl](p.Files) {
+		if g.Tok != token.VAR {
 			continue
 		}
 
-		// Handle error assertions, e.g., `var _ error = ...` where the type is explicit.
-		p.findErrorAssertions(varspec)
+		block := isSentinelBlock(g)
+
+		for _, spec := range g.Specs {
+			varspec, ok := spec.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+
+			// Handle sentinel errors, e.g., `var ErrSomething = ...` where the type is inferred.
+			if varspec.Type == nil {
+				p.findSentinelErrors(varspec, s, block)
+
+				continue
+			}
+
+			// Handle error assertions, e.g., `var _ error = ...` where the type is explicit.
+			p.findErrorAssertions(varspec)
+		}
 	}
 }
 
 // findSentinelErrors checks for sentinel error declarations (`var Err...`).
-func (p pass) findSentinelErrors(varspec *ast.ValueSpec) {
+// All variables of a sentinel block are sentinel errors.
+func (p pass) findSentinelErrors(varspec *ast.ValueSpec, s sentinels, block bool) {
 	for i, id := range varspec.Names {
 		if len(varspec.Values) <= i {
 			break
 		}
 
-		if !strings.HasPrefix(id.Name, "Err") && !strings.HasPrefix(id.Name, "err") {
-			continue
-		}
+		pointer, value := PointerVar, ValueVar
+		if !block && !s.matches(p.Pkg, id.Name) {
+			if !s.packageVars {
+				continue
+			}
 
-		value := varspec.Values[i]
+			pointer, value = PointerPackageVar, ValuePackageVar
+		}
 
-		tv, ok := p.TypesInfo.Types[value]
+		tv, ok := p.TypesInfo.Types[varspec.Values[i]]
 		if !ok || !typeutil.HasErrorMethod(tv.Type) {
 			continue
 		}
 
-		p.recordErrorProperty(tv.Type)
+		p.recordProperty(tv.Type, pointer, value, varspec.Values[i].Pos())
 	}
 }
 
@@ -92,13 +112,16 @@ func (p pass) findErrorAssertions(varspec *ast.ValueSpec) {
 			continue
 		}
 
-		p.recordErrorProperty(tv.Type)
+		p.recordProperty(tv.Type, PointerVar, ValueVar, value.Pos())
 	}
 }
 
-// recordErrorProperty analyzes the given type to determine if it's a pointer or
-// value error and records the property.
-func (p pass) recordErrorProperty(typ types.Type) {
+// recordProperty analyzes the given type to determine if it's a pointer or
+// value error and records the corresponding property with its site.
+//
+// Declarations in test files are recorded as [PointerTest] or [ValueTest], so they only
+// decide when there is no other evidence.
+func (p pass) recordProperty(typ types.Type, pointer, value ErrorProperty, pos token.Pos) {
 	// Interfaces are not concrete error types.
 	if types.IsInterface(typ) {
 		return
@@ -110,13 +133,18 @@ func (p pass) recordErrorProperty(typ types.Type) {
 		return // struct { embedded } or nil
 	}
 
-	errortype := ValueVar
+	errortype := value
 	if isPtr {
-		errortype = PointerVar
+		errortype = pointer
+	}
+
+	if p.inFile(pos).inTest {
+		errortype = errortype.testProperty()
 	}
 
 	// Record usage in the property map.
 	// If the type is defined in the current package, it determines usage.
 	// Otherwise, it's a local override.
 	p.AddTypeProperty(tn, errortype)
+	p.sites.add(tn, errortype, pos)
 }
@@ -0,0 +1,74 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package detect
+
+import (
+	"go/types"
+
+	"fillmore-labs.com/errortype/internal/errortypes"
+	"fillmore-labs.com/errortype/internal/overrides"
+	"fillmore-labs.com/errortype/internal/typeutil"
+)
+
+// Vote holds the usage of error types determined by a module-wide vote, so types left
+// undecided by their defining package get the usage consistently observed in the module.
+//
+// A Vote is filled between analysis runs and must not be modified while a run is in progress.
+type Vote struct {
+	types map[typeutil.TypeName]errortypes.ErrorType
+}
+
+// Set replaces the voted usages. Undecided and suppressed types are ignored.
+func (v *Vote) Set(votes []overrides.Override) {
+	v.types = make(map[typeutil.TypeName]errortypes.ErrorType, len(votes))
+
+	for _, vote := range votes {
+		switch vote.ErrorType {
+		case errortypes.PointerType, errortypes.ValueType:
+			v.types[vote.TypeName] = vote.ErrorType
+		}
+	}
+}
+
+// lookup returns the voted usage of a type.
+func (v *Vote) lookup(tn *types.TypeName) (errortypes.ErrorType, bool) {
+	if v == nil {
+		return errortypes.Undecided, false
+	}
+
+	errorType, ok := v.types[typeutil.NewTypeName(tn)]
+
+	return errorType, ok
+}
+
+// processVote records the voted usage of error types in the current package.
+// Votes have the lowest precedence, so they only decide undetermined types.
+func (p pass) processVote(vote *Vote) {
+	for tn := range p.PropertyMap {
+		if tn.Pkg() != p.Pkg {
+			continue // Votes for other packages are applied when analyzing them.
+		}
+
+		switch errorType, _ := vote.lookup(tn); errorType {
+		case errortypes.PointerType:
+			p.AddTypeProperty(tn, PointerVote)
+
+		case errortypes.ValueType:
+			p.AddTypeProperty(tn, ValueVote)
+		}
+	}
+}
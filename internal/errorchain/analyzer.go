@@ -0,0 +1,49 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errorchain
+
+import (
+	"reflect"
+
+	"golang.org/x/tools/go/analysis"
+
+	"fillmore-labs.com/errortype/internal/flow"
+)
+
+// New creates a new instance of the errorchain analyzer.
+// It determines the concrete error types returned by functions for use by other analyzers.
+func New(opts ...Option) *analysis.Analyzer {
+	o := makeOptions(opts)
+
+	a := &analysis.Analyzer{
+		Name:       "errorchain",
+		Doc:        "Determines the concrete error types in the chain of returned errors for use by other analyzers.",
+		URL:        "https://pkg.go.dev/fillmore-labs.com/errortype/internal/errorchain",
+		Run:        o.run,
+		Requires:   []*analysis.Analyzer{flow.Analyzer},
+		FactTypes:  []analysis.Fact{(*ErrorTypes)(nil), (*Module)(nil)},
+		ResultType: reflect.TypeFor[Result](),
+	}
+
+	a.Flags.BoolVar(&o.impossible, "impossible", o.impossible,
+		"report errors.As targets and type assertions that can never match (et:imp)")
+
+	return a
+}
+
+// Analyzer is a pre-configured *analysis.Analyzer for determining returned error types.
+var Analyzer = New()
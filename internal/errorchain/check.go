@@ -0,0 +1,139 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errorchain
+
+import (
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/ssa"
+
+	"fillmore-labs.com/errortype/internal/typeutil"
+)
+
+// impossible finds errors.As-like calls and type assertions in funcs whose target type can never
+// be found in the inspected error.
+func (s *solver) impossible(funcs []*ssa.Function) map[token.Pos][]Impossible {
+	imp := make(map[token.Pos][]Impossible)
+
+	for _, fn := range funcs {
+		for _, b := range fn.Blocks {
+			for _, instr := range b.Instrs {
+				switch instr := instr.(type) {
+				case *ssa.Call:
+					err, target, ok := errorsAsArgs(instr.Common())
+					if !ok {
+						continue
+					}
+
+					if ptr, ok := target.Type().Underlying().(*types.Pointer); ok {
+						s.check(imp, instr.Pos(), err, ptr.Elem())
+					}
+
+				case *ssa.TypeAssert:
+					if isErrorInterface(instr.X.Type()) {
+						s.check(imp, instr.Pos(), instr.X, instr.AssertedType)
+					}
+				}
+			}
+		}
+	}
+
+	return imp
+}
+
+// check records target at pos when it can never be found in the chain of err.
+func (s *solver) check(imp map[token.Pos][]Impossible, pos token.Pos, err ssa.Value, target types.Type) {
+	if !pos.IsValid() || types.IsInterface(target) {
+		return // Interfaces may be implemented by unknown types.
+	}
+
+	et, ok := TypeOf(target)
+	if !ok {
+		return
+	}
+
+	t := s.newTrace()
+	t.value(err)
+
+	fact := t.set.fact()
+
+	var mismatch string
+	switch {
+	case !fact.MayContain(et):
+		mismatch = s.mismatched(typeName(target).Pkg(), t.producers)
+
+	case fact.Unknown:
+		// Unknown chains may contain any type, but a known producer from a different version or copy of
+		// the target package still rules out this target.
+		if mismatch = s.mismatched(typeName(target).Pkg(), t.producers); mismatch == "" {
+			return
+		}
+
+	default:
+		return
+	}
+
+	imp[pos] = append(imp[pos], Impossible{Target: target, Types: fact, Mismatch: mismatch})
+}
+
+// mismatched returns the smallest module or package path of a producer that is a different major
+// version or copy of the package defining target. Producers from the target package itself
+// may return the target type, so nothing is returned then.
+func (s *solver) mismatched(target *types.Package, producers map[*types.Package]struct{}) string {
+	if _, ok := producers[target]; ok {
+		return ""
+	}
+
+	var mismatch string
+	for producer := range producers {
+		if path, ok := s.mismatch(target, producer); ok && (mismatch == "" || path < mismatch) {
+			mismatch = path
+		}
+	}
+
+	return mismatch
+}
+
+// errorsAsArgs returns the inspected error and the target of an errors.As-like call.
+func errorsAsArgs(c *ssa.CallCommon) (err, target ssa.Value, ok bool) {
+	callee := c.StaticCallee()
+	if callee == nil {
+		return nil, nil, false
+	}
+
+	fun, ok := callee.Object().(*types.Func)
+	if !ok {
+		return nil, nil, false
+	}
+
+	errArgIndex, targetArgIndex, ok := typeutil.ErrorAsArgs(fun)
+	if !ok || targetArgIndex < 0 || targetArgIndex >= len(c.Args) {
+		return nil, nil, false
+	}
+
+	err, target = c.Args[errArgIndex], c.Args[targetArgIndex]
+	if !isErrorInterface(err.Type()) {
+		return nil, nil, false
+	}
+
+	if mi, ok := target.(*ssa.MakeInterface); ok {
+		target = mi.X // Target parameters of type any
+	}
+
+	return err, target, true
+}
@@ -0,0 +1,31 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package errorchain determines the concrete error types that may be found in the chain
+// of errors returned by functions and stored in package-level variables.
+//
+// The result for each function is exported as an [ErrorTypes] fact, so that callers in
+// other packages can build on it. Errors are followed through returns, local and
+// unexported package-level variables, calls to functions with known facts, wrapping via
+// fmt.Errorf and errors.Join, and fields of local wrapping error types whose Unwrap method
+// returns these fields. Everything else, like function parameters, interface method calls,
+// exported variables other packages can assign, or wrapping types declared in other packages,
+// yields the "unknown" top element.
+//
+// With this knowledge, errors.As-like calls and type assertions whose target type can never
+// be found in the inspected error are identified. This includes targets from a different major
+// version or copy of the module producing the error, using [Module] facts.
+package errorchain
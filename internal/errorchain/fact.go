@@ -0,0 +1,188 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errorchain
+
+import (
+	"go/types"
+	"slices"
+	"strings"
+
+	"fillmore-labs.com/errortype/internal/typeutil"
+)
+
+// ErrorTypes is a fact about a function returning an error interface.
+// It lists the concrete error types that may be found in the chain of the error.
+type ErrorTypes struct {
+	// Unknown is set when the error may contain arbitrary types. This is the top element,
+	// and the fact is not exported then.
+	Unknown bool
+
+	// Types lists the possible concrete types, sorted. Empty when the error is always nil.
+	Types []Type
+}
+
+// AFact makes *ErrorTypes satisfy the [analysis.Fact] interface.
+func (*ErrorTypes) AFact() {}
+
+// String returns a string representation of the fact.
+func (e *ErrorTypes) String() string {
+	if e.Unknown {
+		return "unknown"
+	}
+
+	names := make([]string, 0, len(e.Types))
+	for _, t := range e.Types {
+		names = append(names, t.String())
+	}
+
+	return "[" + strings.Join(names, " ") + "]"
+}
+
+// MayContain reports whether an error of type t may be found in the chain of the error.
+func (e *ErrorTypes) MayContain(t Type) bool {
+	if e.Unknown {
+		return true
+	}
+
+	_, found := slices.BinarySearchFunc(e.Types, t, Type.Compare)
+
+	return found
+}
+
+// Type is a named error type or a pointer to a named error type.
+type Type struct {
+	typeutil.TypeName
+	Pointer bool
+}
+
+// TypeOf returns the [Type] of a named type or a pointer to a named type.
+// Type arguments of generic types are ignored.
+func TypeOf(typ types.Type) (Type, bool) {
+	typ = types.Unalias(typ)
+
+	var isPtr bool
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ, isPtr = types.Unalias(ptr.Elem()), true
+	}
+
+	named, ok := typ.(*types.Named)
+	if !ok {
+		return Type{}, false // Anonymous type or type parameter.
+	}
+
+	return Type{TypeName: typeutil.NewTypeName(named.Origin().Obj()), Pointer: isPtr}, true
+}
+
+// typeName returns the type name of a named type or a pointer to a named type, as in [TypeOf].
+func typeName(typ types.Type) *types.TypeName {
+	typ = types.Unalias(typ)
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = types.Unalias(ptr.Elem())
+	}
+
+	if named, ok := typ.(*types.Named); ok {
+		return named.Origin().Obj()
+	}
+
+	return nil
+}
+
+// String returns the fully qualified name of the type ("*pkg/path.TypeName").
+func (t Type) String() string {
+	if t.Pointer {
+		return "*" + t.TypeName.String()
+	}
+
+	return t.TypeName.String()
+}
+
+// Compare compares two [Type] instances, first by name, then values before pointers.
+func (t Type) Compare(other Type) int {
+	if c := t.TypeName.Compare(other.TypeName); c != 0 {
+		return c
+	}
+
+	switch {
+	case t.Pointer == other.Pointer:
+		return 0
+
+	case other.Pointer:
+		return -1
+
+	default:
+		return 1
+	}
+}
+
+// typeSet is the mutable form of [ErrorTypes] used during analysis.
+type typeSet struct {
+	unknown bool
+	types   map[Type]struct{}
+}
+
+// add adds a type to the set.
+func (s *typeSet) add(t Type) {
+	if s.unknown {
+		return
+	}
+
+	if s.types == nil {
+		s.types = make(map[Type]struct{})
+	}
+
+	s.types[t] = struct{}{}
+}
+
+// addFact adds all types of a fact to the set.
+func (s *typeSet) addFact(f *ErrorTypes) {
+	if f.Unknown {
+		s.setUnknown()
+
+		return
+	}
+
+	for _, t := range f.Types {
+		s.add(t)
+	}
+}
+
+// setUnknown sets the set to the top element.
+func (s *typeSet) setUnknown() {
+	s.unknown, s.types = true, nil
+}
+
+// fact converts the set into an [ErrorTypes] fact.
+func (s *typeSet) fact() *ErrorTypes {
+	if s.unknown {
+		return &ErrorTypes{Unknown: true}
+	}
+
+	typs := make([]Type, 0, len(s.types))
+	for t := range s.types {
+		typs = append(typs, t)
+	}
+
+	slices.SortFunc(typs, Type.Compare)
+
+	return &ErrorTypes{Types: typs}
+}
+
+// sameSize reports whether two sets have the same size. Since sets only grow during
+// analysis, this detects changes.
+func (s *typeSet) sameSize(other *typeSet) bool {
+	return s.unknown == other.unknown && len(s.types) == len(other.types)
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errorchain
+
+import (
+	"go/types"
+	"strings"
+)
+
+// Module is a fact about a package, recording the module it belongs to.
+type Module struct {
+	Path    string
+	Version string
+}
+
+// AFact makes *Module satisfy the [analysis.Fact] interface.
+func (*Module) AFact() {}
+
+// String returns a string representation of the fact.
+func (m *Module) String() string {
+	if m.Version == "" {
+		return m.Path
+	}
+
+	return m.Path + "@" + m.Version
+}
+
+// exportModule exports the module of the current package as a fact.
+func (s *solver) exportModule() {
+	if s.Module == nil || s.Module.Path == "" {
+		return
+	}
+
+	s.ExportPackageFact(&Module{Path: s.Module.Path, Version: s.Module.Version})
+}
+
+// moduleOf returns the module of pkg, if known.
+func (s *solver) moduleOf(pkg *types.Package) (*Module, bool) {
+	if pkg == s.Pkg {
+		if s.Module == nil || s.Module.Path == "" {
+			return nil, false
+		}
+
+		return &Module{Path: s.Module.Path, Version: s.Module.Version}, true
+	}
+
+	var m Module
+	if !s.ImportPackageFact(pkg, &m) {
+		return nil, false
+	}
+
+	return &m, true
+}
+
+// mismatch returns the module or package path of producer when it is a different major
+// version or a different copy of the module or package defining target.
+func (s *solver) mismatch(target, producer *types.Package) (string, bool) {
+	if target == nil || producer == nil || target == producer {
+		return "", false
+	}
+
+	// Vendored copies in GOPATH mode: "a/vendor/example.com/p" vs. "example.com/p".
+	if t, p := target.Path(), producer.Path(); t != p && unvendor(t) == unvendor(p) {
+		return p, true
+	}
+
+	tm, ok := s.moduleOf(target)
+	if !ok {
+		return "", false
+	}
+
+	pm, ok := s.moduleOf(producer)
+	if !ok || tm.Path == pm.Path {
+		return "", false
+	}
+
+	if modulePrefix(tm.Path) != modulePrefix(pm.Path) {
+		return "", false // Unrelated modules.
+	}
+
+	return pm.Path, true
+}
+
+// unvendor strips a vendor directory prefix from a package path.
+func unvendor(path string) string {
+	if i := strings.LastIndex(path, "/vendor/"); i >= 0 {
+		return path[i+len("/vendor/"):]
+	}
+
+	return strings.TrimPrefix(path, "vendor/")
+}
+
+// modulePrefix returns the module path without its major version suffix,
+// like "example.com/m" for "example.com/m/v2" or "gopkg.in/yaml" for "gopkg.in/yaml.v3".
+func modulePrefix(path string) string {
+	sep := "/v"
+	if strings.HasPrefix(path, "gopkg.in/") {
+		sep = ".v"
+	}
+
+	i := strings.LastIndex(path, sep)
+	if i < 0 || !isMajor(path[i+len(sep):]) {
+		return path
+	}
+
+	return path[:i]
+}
+
+// isMajor reports whether v is a major version number, like "2" or "10".
+func isMajor(v string) bool {
+	if v == "" || v[0] == '0' {
+		return false
+	}
+
+	for _, c := range v {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errorchain
+
+import "log/slog"
+
+type options struct {
+	// impossible enables the analysis, which is opt-in.
+	impossible bool
+}
+
+// defaultOptions returns a [options] struct initialized with default values.
+func defaultOptions() *options {
+	return &options{ // Default options
+		impossible: false,
+	}
+}
+
+// makeOptions returns a [options] struct with overriding [Option]s applied.
+func makeOptions(opts Options) *options {
+	o := defaultOptions()
+	opts.apply(o)
+
+	return o
+}
+
+// Option configures specific behavior of the errorchain [analysis.Analyzer].
+type Option interface {
+	LogValue() slog.Value
+	key() string
+	apply(opts *options)
+}
+
+// Options is a list of [Option] values that also satisfies the [Option] interface.
+type Options []Option
+
+// LogValue implements the [slog.LogValuer] interface.
+func (o Options) LogValue() slog.Value {
+	as := make([]slog.Attr, 0, len(o))
+	for _, opt := range o {
+		as = append(as, slog.Attr{Key: opt.key(), Value: opt.LogValue()})
+	}
+
+	return slog.GroupValue(as...)
+}
+
+func (o Options) key() string { return "options" }
+
+func (o Options) apply(opts *options) {
+	for _, opt := range o {
+		opt.apply(opts)
+	}
+}
+
+// WithImpossible is an [Option] to enable the detection of errors.As targets and
+// type assertions that can never match.
+func WithImpossible(impossible bool) Option { return impossibleOption{impossible: impossible} }
+
+type impossibleOption struct{ impossible bool }
+
+// LogValue implements the [slog.LogValuer] interface.
+func (o impossibleOption) LogValue() slog.Value { return slog.BoolValue(o.impossible) }
+
+func (o impossibleOption) key() string { return "impossible" }
+
+func (o impossibleOption) apply(opts *options) { opts.impossible = o.impossible }
@@ -0,0 +1,55 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errorchain
+
+import (
+	"go/token"
+	"go/types"
+)
+
+// Impossible is a target type that can never be found in the inspected error.
+type Impossible struct {
+	// Target is the target type of the errors.As-like call or type assertion.
+	Target types.Type
+
+	// Types are the possible types in the chain of the inspected error.
+	Types *ErrorTypes
+
+	// Mismatch is the module or package path of a function, variable or type producing the
+	// inspected error that is a different major version or copy of the target type's
+	// module or package. Empty when there is none.
+	Mismatch string
+}
+
+// Result is the result of the errorchain analyzer.
+type Result struct {
+	// Impossible maps positions to targets that can never match. Positions are the opening
+	// parenthesis of errors.As-like calls and type assertions, and the "case" keyword of
+	// type switch clauses.
+	Impossible map[token.Pos][]Impossible
+}
+
+// Find returns the target that can never match at pos.
+func (r Result) Find(pos token.Pos, target types.Type) (Impossible, bool) {
+	for _, imp := range r.Impossible[pos] {
+		if types.Identical(imp.Target, target) {
+			return imp, true
+		}
+	}
+
+	return Impossible{}, false
+}
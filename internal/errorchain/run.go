@@ -0,0 +1,64 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errorchain
+
+import (
+	"errors"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+
+	"fillmore-labs.com/errortype/internal/flow"
+)
+
+// ErrNoSSAResult is returned when the result from the SSA analyzer is missing.
+var ErrNoSSAResult = errors.New("errorchain: SSA result missing")
+
+// run is the main function for the errorchain analyzer.
+//
+// It determines the error types returned by functions and stored in unexported package-level
+// variables of the current package, exports the former as facts and finds targets that can never match.
+func (o *options) run(ap *analysis.Pass) (any, error) {
+	if !o.impossible {
+		return Result{}, nil // Opt-in, since solving all dependencies is costly.
+	}
+
+	ssaResult, ok := ap.ResultOf[flow.Analyzer].(*flow.SSA)
+	if !ok {
+		return nil, ErrNoSSAResult
+	}
+
+	if ssaResult.Pkg == nil {
+		return Result{}, nil // Package with type errors, everything is unknown.
+	}
+
+	funcs := ssaResult.SrcFuncs
+
+	// The package initializer stores initial values into package-level variables.
+	if init := ssaResult.Pkg.Func("init"); init != nil {
+		funcs = flow.AppendWithAnons(slices.Clip(funcs), init)
+	}
+
+	s := newSolver(ap, funcs)
+
+	s.solve()
+
+	s.exportFacts()
+	s.exportModule()
+
+	return Result{Impossible: s.impossible(funcs)}, nil
+}
@@ -0,0 +1,215 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errorchain
+
+import (
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ssa"
+
+	"fillmore-labs.com/errortype/internal/typeutil"
+)
+
+// solver determines the error types of functions and package-level variables in the current package.
+type solver struct {
+	*analysis.Pass
+
+	// funcs holds the returned error types of functions in the current package.
+	funcs map[*ssa.Function]*typeSet
+
+	// globals holds the error types of package-level variables in the current package.
+	globals map[*ssa.Global]*typeSet
+
+	// stores lists the values stored into package-level variables of the current package.
+	stores map[*ssa.Global][]ssa.Value
+
+	// methods holds the source methods of the current package.
+	methods map[*types.Func]*ssa.Function
+}
+
+// newSolver creates a solver for the given functions of the current package.
+func newSolver(ap *analysis.Pass, funcs []*ssa.Function) *solver {
+	s := &solver{
+		Pass:    ap,
+		funcs:   make(map[*ssa.Function]*typeSet),
+		globals: make(map[*ssa.Global]*typeSet),
+		stores:  make(map[*ssa.Global][]ssa.Value),
+		methods: make(map[*types.Func]*ssa.Function),
+	}
+
+	for _, fn := range funcs {
+		if fun, ok := fn.Object().(*types.Func); ok && fn.Signature.Recv() != nil {
+			s.methods[fun] = fn
+		}
+
+		if hasErrorResult(fn) {
+			s.funcs[fn] = &typeSet{}
+		}
+
+		s.scanGlobals(fn)
+	}
+
+	return s
+}
+
+// scanGlobals records all values stored into package-level error variables of the current
+// package in fn. Variables whose address escapes are unknown, as well as exported variables,
+// which other packages can assign.
+func (s *solver) scanGlobals(fn *ssa.Function) {
+	var operands []*ssa.Value
+
+	for _, b := range fn.Blocks {
+		for _, instr := range b.Instrs {
+			operands = instr.Operands(operands[:0])
+			for _, op := range operands {
+				g, ok := (*op).(*ssa.Global)
+				if !ok || g.Pkg == nil || g.Pkg.Pkg != s.Pkg || !isErrorInterface(deref(g.Type())) {
+					continue
+				}
+
+				set, ok := s.globals[g]
+				if !ok {
+					set = &typeSet{}
+					if g.Object().Exported() {
+						set.setUnknown()
+					}
+
+					s.globals[g] = set
+				}
+
+				switch instr := instr.(type) {
+				case *ssa.Store:
+					if instr.Addr == g {
+						s.stores[g] = append(s.stores[g], instr.Val)
+
+						continue
+					}
+
+				case *ssa.UnOp:
+					if instr.Op == token.MUL {
+						continue // Load
+					}
+
+				case *ssa.DebugRef:
+					continue
+				}
+
+				set.setUnknown() // Address escapes.
+			}
+		}
+	}
+}
+
+// solve iterates until the error types of all functions and variables are stable.
+// Sets only grow, and unknown is the top element, so this terminates.
+func (s *solver) solve() {
+	for changed := true; changed; {
+		changed = false
+
+		for fn, old := range s.funcs {
+			set := s.results(fn)
+			if !set.sameSize(old) {
+				s.funcs[fn], changed = set, true
+			}
+		}
+
+		for g, old := range s.globals {
+			if old.unknown {
+				continue
+			}
+
+			t := s.newTrace()
+			for _, v := range s.stores[g] {
+				t.value(v)
+			}
+
+			if !t.set.sameSize(old) {
+				s.globals[g], changed = t.set, true
+			}
+		}
+	}
+}
+
+// results determines the error types returned by fn.
+func (s *solver) results(fn *ssa.Function) *typeSet {
+	t := s.newTrace()
+
+	if fn.Blocks == nil {
+		t.set.setUnknown() // External function without a body.
+
+		return t.set
+	}
+
+	results := fn.Signature.Results()
+
+	for _, b := range fn.Blocks {
+		ret, ok := b.Instrs[len(b.Instrs)-1].(*ssa.Return)
+		if !ok {
+			continue
+		}
+
+		for i, res := range ret.Results {
+			if i < results.Len() && isErrorInterface(results.At(i).Type()) {
+				t.value(res)
+			}
+		}
+	}
+
+	return t.set
+}
+
+// exportFacts exports the error types of functions of the current package. Package-level variables
+// are only known within their package, since other packages can access exported variables only.
+func (s *solver) exportFacts() {
+	for fn, set := range s.funcs {
+		if set.unknown {
+			continue // Absent facts are unknown.
+		}
+
+		if fun, ok := fn.Object().(*types.Func); ok {
+			s.ExportObjectFact(fun, set.fact())
+		}
+	}
+}
+
+// hasErrorResult reports whether fn has a result of an error interface type.
+func hasErrorResult(fn *ssa.Function) bool {
+	results := fn.Signature.Results()
+	for i := range results.Len() {
+		if isErrorInterface(results.At(i).Type()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isErrorInterface reports whether typ is an interface type implementing error.
+func isErrorInterface(typ types.Type) bool {
+	return types.IsInterface(typ) && typeutil.HasErrorMethod(typ)
+}
+
+// deref returns the element type of a pointer type.
+func deref(typ types.Type) types.Type {
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		return ptr.Elem()
+	}
+
+	return typ
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved. // want package:" test}$"
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"test/a/b"
+)
+
+type (
+	MyError         struct{}
+	AsError         struct{}
+	WrapError       struct{ err error }
+	GlobalWrapError struct{ err error }
+	StructWrapError struct{ inner inner }
+	inner           struct{ err error }
+)
+
+func (*MyError) Error() string     { return "" }
+func (AsError) Error() string      { return "" }
+func (AsError) As(any) bool        { return true }
+func (*WrapError) Error() string   { return "" }
+func (w *WrapError) Unwrap() error { return w.err }
+
+func (*GlobalWrapError) Error() string   { return "" }
+func (*GlobalWrapError) Unwrap() error   { return errLocal } // want Unwrap:"^\\[\\*test/a.MyError\\]$"
+func (*StructWrapError) Error() string   { return "" }
+func (w *StructWrapError) Unwrap() error { return w.inner.err }
+
+var errLocal error = &MyError{}
+
+var ErrSentinel = errors.New("sentinel")
+
+func Nil() error { return nil } // want Nil:"^\\[\\]$"
+
+func Direct(ok bool) error { // want Direct:"^\\[\\*test/a.MyError test/a/b.ValueError\\]$"
+	if ok {
+		return &MyError{}
+	}
+
+	return b.ValueError{}
+}
+
+func Variable(ok bool) error { // want Variable:"^\\[\\*test/a.MyError\\]$"
+	var err error
+	if ok {
+		err = errLocal
+	}
+
+	return err
+}
+
+func Calls(i int) (err error) { // want Calls:"^\\[\\*test/a.MyError \\*test/a/b.PointerError test/a/b.ValueError\\]$"
+	switch i {
+	case 0:
+		return b.Value()
+
+	case 1:
+		_, err = b.Pointer()
+
+		return err
+
+	default:
+		return Direct(false)
+	}
+}
+
+func Recursive(i int) error { // want Recursive:"^\\[\\*test/a.MyError\\]$"
+	if i > 0 {
+		return Recursive(i - 1)
+	}
+
+	return &MyError{}
+}
+
+func Wrapped(ok bool) error { // want Wrapped:"^\\[\\*errors.errorString \\*fmt.wrapError \\*fmt.wrapErrors \\*test/a.MyError \\*test/a.WrapError test/a/b.ValueError\\]$"
+	if ok {
+		return fmt.Errorf("wrapped: %w", &MyError{})
+	}
+
+	return &WrapError{err: b.Value()}
+}
+
+func Joined() error { // want Joined:"^\\[\\*errors.joinError \\*test/a.MyError test/a/b.ValueError\\]$"
+	return errors.Join(&MyError{}, b.ValueError{})
+}
+
+func Param(err error) error {
+	return err
+}
+
+func CustomAs() error {
+	return AsError{}
+}
+
+func Dependency() error {
+	return b.ErrSentinel // Exported variables can be assigned by any package.
+}
+
+func Exported() error {
+	return ErrSentinel
+}
+
+func WrappedDependency() error {
+	return b.WrapError{Err: &MyError{}} // Unwrap is declared in another package.
+}
+
+func WrappedGlobal() error {
+	return &GlobalWrapError{err: &MyError{}} // Unwrap doesn't return a field.
+}
+
+func WrappedStruct() error { // want WrappedStruct:"^\\[\\*test/a.StructWrapError\\]$"
+	return &StructWrapError{}
+}
+
+func WrappedNested() error {
+	return &StructWrapError{inner: inner{err: &MyError{}}} // The wrapped struct holds an error.
+}
+
+func Interface(r io.Reader) error {
+	_, err := r.Read(nil)
+
+	return err
+}
+
+func Captured() error {
+	var err error
+
+	func() { err = &MyError{} }()
+
+	return err
+}
@@ -0,0 +1,5 @@
+module test
+
+go 1.24.0
+
+toolchain go1.25.0
@@ -0,0 +1,511 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errorchain
+
+import (
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/ssa"
+
+	"fillmore-labs.com/errortype/internal/typeutil"
+)
+
+// wrappers are functions wrapping their variadic error arguments, with the types they return.
+// They are handled explicitly, since their facts are unknown due to the untyped arguments.
+var wrappers = map[typeutil.FuncName][]Type{
+	{Path: "fmt", Name: "Errorf"}: {
+		{TypeName: typeutil.TypeName{Path: "errors", Name: "errorString"}, Pointer: true},
+		{TypeName: typeutil.TypeName{Path: "fmt", Name: "wrapError"}, Pointer: true},
+		{TypeName: typeutil.TypeName{Path: "fmt", Name: "wrapErrors"}, Pointer: true},
+	},
+	{Path: "errors", Name: "Join"}: {
+		{TypeName: typeutil.TypeName{Path: "errors", Name: "joinError"}, Pointer: true},
+	},
+}
+
+// trace collects the error types of values into a set.
+type trace struct {
+	*solver
+	set       *typeSet
+	visited   map[ssa.Value]struct{}
+	producers map[*types.Package]struct{} // Packages of called functions, variables and created types.
+}
+
+// newTrace starts a new trace with an empty set.
+func (s *solver) newTrace() *trace {
+	return &trace{
+		solver:    s,
+		set:       &typeSet{},
+		visited:   make(map[ssa.Value]struct{}),
+		producers: make(map[*types.Package]struct{}),
+	}
+}
+
+// value adds the types in the chain of the error interface value v.
+func (t *trace) value(v ssa.Value) {
+	if t.set.unknown || !t.visit(v) {
+		return
+	}
+
+	switch v := v.(type) {
+	case *ssa.Const:
+		// nil
+
+	case *ssa.MakeInterface:
+		t.concrete(v.X)
+
+	case *ssa.ChangeInterface:
+		t.value(v.X)
+
+	case *ssa.Phi:
+		for _, edge := range v.Edges {
+			t.value(edge)
+		}
+
+	case *ssa.Call:
+		t.call(v.Common())
+
+	case *ssa.Extract:
+		if call, ok := v.Tuple.(*ssa.Call); ok {
+			t.call(call.Common())
+		} else {
+			t.set.setUnknown() // Comma-ok expression.
+		}
+
+	case *ssa.UnOp:
+		t.load(v)
+
+	default:
+		t.set.setUnknown() // Parameters, fields, interface conversions, ...
+	}
+}
+
+// concrete adds the concrete type of x and the types wrapped by x.
+func (t *trace) concrete(x ssa.Value) {
+	if phi, ok := x.(*ssa.Phi); ok {
+		if t.visit(phi) {
+			for _, edge := range phi.Edges {
+				t.concrete(edge)
+			}
+		}
+
+		return
+	}
+
+	typ := x.Type()
+
+	et, ok := TypeOf(typ)
+	if !ok || hasMethod(typ, "As") { // Custom As methods can match anything.
+		t.set.setUnknown()
+
+		return
+	}
+
+	t.set.add(et)
+	t.produced(typeName(typ).Pkg())
+
+	if hasMethod(typ, "Unwrap") {
+		t.wrapped(x)
+	}
+}
+
+// wrapped adds the types wrapped by the error x with an Unwrap method. Only errors
+// stored in fields of a local x are traced, when the Unwrap method of its type returns
+// fields of the receiver. Other wrapped errors are unknown.
+func (t *trace) wrapped(x ssa.Value) {
+	if !t.unwrapsFields(x.Type()) {
+		t.set.setUnknown()
+
+		return
+	}
+
+	switch x := x.(type) {
+	case *ssa.Alloc: // &T{...}
+		t.fields(x)
+
+	case *ssa.UnOp: // T{...}
+		if alloc, ok := x.X.(*ssa.Alloc); ok && x.Op == token.MUL {
+			t.fields(alloc)
+		} else {
+			t.set.setUnknown()
+		}
+
+	case *ssa.Const:
+		// Zero value or nil pointer, nothing wrapped.
+
+	default:
+		t.set.setUnknown()
+	}
+}
+
+// unwrapsFields reports whether the Unwrap method of typ is declared in the current package
+// and only returns nil or fields of its receiver.
+func (t *trace) unwrapsFields(typ types.Type) bool {
+	obj, _, _ := types.LookupFieldOrMethod(typ, false, nil, "Unwrap")
+
+	fun, ok := obj.(*types.Func)
+	if !ok {
+		return false
+	}
+
+	fn, ok := t.methods[fun]
+	if !ok || len(fn.Params) == 0 {
+		return false // Declared in another package or promoted from an embedded field.
+	}
+
+	recv := fn.Params[0]
+
+	for _, b := range fn.Blocks {
+		ret, ok := b.Instrs[len(b.Instrs)-1].(*ssa.Return)
+		if !ok {
+			continue
+		}
+
+		for _, res := range ret.Results {
+			if !isReceiverField(res, recv) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// isReceiverField reports whether v is nil or a (nested) field of the receiver recv.
+func isReceiverField(v ssa.Value, recv *ssa.Parameter) bool {
+	switch v := v.(type) {
+	case *ssa.Const:
+		return v.IsNil()
+
+	case *ssa.Field: // Value receiver.
+		return v.X == recv || isReceiverField(v.X, recv)
+
+	case *ssa.UnOp: // Pointer receiver.
+		return v.Op == token.MUL && isReceiverFieldAddr(v.X, recv)
+	}
+
+	return false
+}
+
+// isReceiverFieldAddr reports whether v is the address of a (nested) field of the pointer receiver recv.
+func isReceiverFieldAddr(v ssa.Value, recv *ssa.Parameter) bool {
+	addr, ok := v.(*ssa.FieldAddr)
+
+	return ok && (addr.X == recv || isReceiverFieldAddr(addr.X, recv))
+}
+
+// fields adds the types of all values stored in fields of a locally allocated struct.
+func (t *trace) fields(alloc *ssa.Alloc) {
+	if !t.visit(alloc) {
+		return
+	}
+
+	for _, ref := range *alloc.Referrers() {
+		switch ref := ref.(type) {
+		case *ssa.FieldAddr:
+			t.stored(ref)
+
+		case *ssa.Store:
+			if ref.Addr != alloc {
+				t.set.setUnknown() // Address escapes.
+
+				return
+			}
+
+			t.wrapped(ref.Val)
+
+		case *ssa.UnOp, *ssa.MakeInterface, *ssa.DebugRef:
+
+		default:
+			t.set.setUnknown() // Address escapes.
+
+			return
+		}
+	}
+}
+
+// stored adds the types of all values stored at addr.
+func (t *trace) stored(addr ssa.Value) {
+	for _, ref := range *addr.Referrers() {
+		switch ref := ref.(type) {
+		case *ssa.Store:
+			if ref.Addr != addr {
+				t.set.setUnknown() // Address escapes.
+
+				return
+			}
+
+			t.element(ref.Val)
+
+		case *ssa.UnOp, *ssa.DebugRef:
+
+		default:
+			t.set.setUnknown() // Address escapes.
+
+			return
+		}
+	}
+}
+
+// element adds the types of a wrapped value, which is not necessarily an error.
+func (t *trace) element(v ssa.Value) {
+	typ := v.Type()
+
+	switch {
+	case isErrorInterface(typ):
+		t.value(v)
+
+	case types.IsInterface(typ):
+		switch v := v.(type) {
+		case *ssa.MakeInterface:
+			if typeutil.HasErrorMethod(v.X.Type()) {
+				t.concrete(v.X)
+			} // Other values are not wrapped.
+
+		case *ssa.ChangeInterface:
+			t.element(v.X)
+
+		default:
+			t.set.setUnknown() // Might hold an error.
+		}
+
+	case typeutil.HasErrorMethod(typ):
+		t.concrete(v)
+
+	case mayHoldError(typ, make(map[types.Type]struct{})):
+		t.set.setUnknown() // Structs, pointers, collections and functions might hold errors.
+	}
+}
+
+// mayHoldError reports whether a value of type typ can reference an error.
+func mayHoldError(typ types.Type, seen map[types.Type]struct{}) bool {
+	if _, ok := seen[typ]; ok {
+		return false
+	}
+
+	seen[typ] = struct{}{}
+
+	switch u := typ.Underlying().(type) {
+	case *types.Basic:
+		return false
+
+	case *types.Pointer:
+		return mayHoldError(u.Elem(), seen)
+
+	case *types.Slice:
+		return mayHoldError(u.Elem(), seen)
+
+	case *types.Array:
+		return mayHoldError(u.Elem(), seen)
+
+	case *types.Chan:
+		return mayHoldError(u.Elem(), seen)
+
+	case *types.Map:
+		return mayHoldError(u.Key(), seen) || mayHoldError(u.Elem(), seen)
+
+	case *types.Struct:
+		for field := range u.Fields() {
+			if mayHoldError(field.Type(), seen) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return true // Interfaces, functions, type parameters.
+}
+
+// variadic adds the types of the elements of a variadic argument.
+func (t *trace) variadic(v ssa.Value) {
+	switch v := v.(type) {
+	case *ssa.Const:
+		// nil slice
+
+	case *ssa.Slice:
+		arr, ok := v.X.(*ssa.Alloc)
+		if !ok {
+			t.set.setUnknown()
+
+			return
+		}
+
+		for _, ref := range *arr.Referrers() {
+			switch ref := ref.(type) {
+			case *ssa.IndexAddr:
+				t.stored(ref)
+
+			case *ssa.Slice, *ssa.DebugRef:
+
+			default:
+				t.set.setUnknown()
+
+				return
+			}
+		}
+
+	default:
+		t.set.setUnknown() // f(args...)
+	}
+}
+
+// call adds the types returned by a function call.
+func (t *trace) call(c *ssa.CallCommon) {
+	callee := c.StaticCallee()
+	if callee == nil {
+		t.set.setUnknown() // Dynamic call or interface method.
+
+		return
+	}
+
+	if origin := callee.Origin(); origin != nil {
+		callee = origin
+	}
+
+	fun, _ := callee.Object().(*types.Func)
+
+	if fun != nil {
+		if own, ok := wrappers[typeutil.FuncNameOf(fun)]; ok {
+			for _, et := range own {
+				t.set.add(et)
+			}
+
+			if len(c.Args) > 0 {
+				t.variadic(c.Args[len(c.Args)-1])
+			}
+
+			return
+		}
+	}
+
+	if fun != nil {
+		t.produced(fun.Pkg())
+	}
+
+	if set, ok := t.funcs[callee]; ok {
+		t.union(set)
+
+		return
+	}
+
+	var fact ErrorTypes
+	if fun == nil || fun.Pkg() == t.Pkg || !t.ImportObjectFact(fun, &fact) {
+		t.set.setUnknown()
+
+		return
+	}
+
+	t.set.addFact(&fact)
+}
+
+// load adds the types of a value loaded from a variable.
+func (t *trace) load(v *ssa.UnOp) {
+	if v.Op != token.MUL {
+		t.set.setUnknown()
+
+		return
+	}
+
+	switch x := v.X.(type) {
+	case *ssa.Alloc:
+		t.local(x)
+
+	case *ssa.Global:
+		t.global(x)
+
+	default:
+		t.set.setUnknown()
+	}
+}
+
+// local adds the types of all values stored into a local variable.
+func (t *trace) local(alloc *ssa.Alloc) {
+	for _, ref := range *alloc.Referrers() {
+		switch ref := ref.(type) {
+		case *ssa.Store:
+			if ref.Addr != alloc {
+				t.set.setUnknown() // Address escapes.
+
+				return
+			}
+
+			t.value(ref.Val)
+
+		case *ssa.UnOp, *ssa.DebugRef:
+
+		default:
+			t.set.setUnknown() // Captured or address escapes.
+
+			return
+		}
+	}
+}
+
+// global adds the types of a package-level variable of the current package. Variables of other
+// packages are unknown, since they are exported and can be assigned by any package.
+func (t *trace) global(g *ssa.Global) {
+	t.produced(g.Pkg.Pkg)
+
+	if set, ok := t.globals[g]; ok {
+		t.union(set)
+
+		return
+	}
+
+	t.set.setUnknown()
+}
+
+// produced records pkg as a producer of the traced error.
+func (t *trace) produced(pkg *types.Package) {
+	if pkg != nil {
+		t.producers[pkg] = struct{}{}
+	}
+}
+
+// union adds all types of another set.
+func (t *trace) union(set *typeSet) {
+	if set.unknown {
+		t.set.setUnknown()
+
+		return
+	}
+
+	for et := range set.types {
+		t.set.add(et)
+	}
+}
+
+// visit returns true when v has not been visited yet.
+func (t *trace) visit(v ssa.Value) bool {
+	if _, ok := t.visited[v]; ok {
+		return false
+	}
+
+	t.visited[v] = struct{}{}
+
+	return true
+}
+
+// hasMethod reports whether typ has a method with the given name.
+func hasMethod(typ types.Type, name string) bool {
+	obj, _, _ := types.LookupFieldOrMethod(typ, false, nil, name)
+	_, ok := obj.(*types.Func)
+
+	return ok
+}
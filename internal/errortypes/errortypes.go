@@ -48,6 +48,54 @@ func (e ErrorType) String() string {
 	return fmt.Sprintf("Unknown(%d)", e)
 }
 
-// AFact makes *ErrorType satisfy the [analysis.Fact] interface.
+// Confidence represents how firmly the usage of an error type is established.
+type Confidence byte
+
+// Constants defining the confidence levels, from the weakest to the strongest.
+const (
+	// HeuristicConfidence is used for usages determined by weak heuristics, like doc comments,
+	// consistent method receivers, usage in tests, or a module-wide vote.
+	HeuristicConfidence Confidence = iota
+
+	// UsageConfidence is used for usages determined by constructors and the usage in function bodies.
+	UsageConfidence
+
+	// ExplicitConfidence is used for usages determined by the type definition, directives,
+	// variable declarations, aliases, or overrides.
+	ExplicitConfidence
+)
+
+// String returns a string representation of the Confidence.
+func (c Confidence) String() string {
+	switch c {
+	case HeuristicConfidence:
+		return "heuristic"
+
+	case UsageConfidence:
+		return "usage"
+
+	case ExplicitConfidence:
+		return "explicit"
+	}
+
+	return fmt.Sprintf("Unknown(%d)", c)
+}
+
+// Determined represents a determined usage of an error type with the confidence of the decision.
+type Determined struct {
+	ErrorType  ErrorType
+	Confidence Confidence
+}
+
+// String returns a string representation of the Determined usage.
+func (d Determined) String() string {
+	if d.ErrorType == Undecided {
+		return d.ErrorType.String()
+	}
+
+	return fmt.Sprintf("%s (%s)", d.ErrorType, d.Confidence)
+}
+
+// AFact makes *Determined satisfy the [analysis.Fact] interface.
 // [analysis.Fact]s must be pointers to be exported as a fact.
-func (*ErrorType) AFact() {}
+func (*Determined) AFact() {}
@@ -0,0 +1,158 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"go/ast"
+	"go/types"
+	"reflect"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ssa"
+)
+
+// SSA is the result of [Analyzer].
+type SSA struct {
+	// Pkg is the SSA package, giving access to package-level variables and the package initializer.
+	// It is nil for unsupported packages and packages with type errors.
+	Pkg *ssa.Package
+
+	// SrcFuncs are all source functions of the package, including function literals.
+	SrcFuncs []*ssa.Function
+}
+
+// Analyzer builds the SSA form of a package once for all analyzers tracking error values.
+//
+// It is equivalent to [golang.org/x/tools/go/analysis/passes/buildssa], but records
+// debug information, so values can be mapped back to the expressions creating them.
+// Packages with type errors are skipped, since the SSA builder requires well-typed code,
+// as well as packages using syntax the builder does not support.
+var Analyzer = &analysis.Analyzer{
+	Name:             "errorflowssa",
+	Doc:              "Builds the SSA form of a package with debug information for tracking error values.",
+	URL:              "https://pkg.go.dev/fillmore-labs.com/errortype/internal/flow",
+	Run:              buildSSA,
+	RunDespiteErrors: true,
+	ResultType:       reflect.TypeFor[*SSA](),
+}
+
+// buildSSA builds the SSA form of the package of the current pass.
+func buildSSA(ap *analysis.Pass) (any, error) {
+	if ap.TypesInfo == nil || len(ap.TypeErrors) > 0 || !supported(ap) {
+		return &SSA{}, nil
+	}
+
+	prog := ssa.NewProgram(ap.Fset, ssa.GlobalDebug)
+
+	// Create SSA packages for direct imports.
+	for _, p := range ap.Pkg.Imports() {
+		prog.CreatePackage(p, nil, nil, true)
+	}
+
+	// Create and build the primary package.
+	ssapkg := prog.CreatePackage(ap.Pkg, ap.Files, ap.TypesInfo, false)
+	ssapkg.Build()
+
+	var funcs []*ssa.Function
+
+	for _, f := range ap.Files {
+		for _, decl := range f.Decls {
+			fdecl, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+
+			fun, ok := ap.TypesInfo.Defs[fdecl.Name].(*types.Func)
+			if !ok {
+				continue
+			}
+
+			if fn := prog.FuncValue(fun); fn != nil {
+				funcs = AppendWithAnons(funcs, fn)
+			}
+		}
+	}
+
+	return &SSA{Pkg: ssapkg, SrcFuncs: funcs}, nil
+}
+
+// supported reports whether the SSA builder supports the syntax of a package.
+//
+// Keys of struct literals naming promoted fields (Go 1.27) are not supported by the builder
+// of our golang.org/x/tools dependency.
+func supported(ap *analysis.Pass) bool {
+	for _, f := range ap.Files {
+		ok := true
+
+		ast.Inspect(f, func(n ast.Node) bool {
+			lit, isLit := n.(*ast.CompositeLit)
+			if !ok || !isLit {
+				return ok
+			}
+
+			t := ap.TypesInfo.TypeOf(lit)
+			if t == nil {
+				return true
+			}
+
+			st, isStruct := t.Underlying().(*types.Struct)
+			if !isStruct {
+				return true
+			}
+
+			for _, elt := range lit.Elts {
+				if kv, isKV := elt.(*ast.KeyValueExpr); isKV && !hasField(st, kv.Key) {
+					ok = false
+				}
+			}
+
+			return ok
+		})
+
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasField reports whether key names a field declared directly in the struct.
+func hasField(st *types.Struct, key ast.Expr) bool {
+	id, ok := key.(*ast.Ident)
+	if !ok {
+		return false
+	}
+
+	for field := range st.Fields() {
+		if field.Name() == id.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AppendWithAnons appends fn and all function literals nested in it to funcs.
+func AppendWithAnons(funcs []*ssa.Function, fn *ssa.Function) []*ssa.Function {
+	funcs = append(funcs, fn)
+	for _, anon := range fn.AnonFuncs {
+		funcs = AppendWithAnons(funcs, anon)
+	}
+
+	return funcs
+}
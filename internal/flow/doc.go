@@ -0,0 +1,29 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package flow provides a flow-sensitive view on concrete error values, based on
+// the SSA form of a package.
+//
+// It tracks concrete error types that are converted to an interface and reach
+// a place where they are used as an error, through local variables and phi nodes:
+//
+//   - Results of a function of an error interface type
+//   - Type assertions and type switches on an error
+//   - The inspected error of an errors.As-like function
+//
+// This catches cases where the static type of the used expression is an interface,
+// like in "var err error = &ValueError{}; ...; return err".
+package flow
@@ -0,0 +1,310 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"iter"
+
+	"golang.org/x/tools/go/ssa"
+
+	"fillmore-labs.com/errortype/internal/typeutil"
+)
+
+// Sink describes how a concrete error value is used.
+type Sink uint8
+
+const (
+	// SinkReturn is a result of the function.
+	SinkReturn Sink = iota + 1
+
+	// SinkAssert is a type assertion or type switch.
+	SinkAssert
+
+	// SinkErrorsAs is the inspected error of an errors.As-like function.
+	SinkErrorsAs
+)
+
+// String returns a string representation of the Sink.
+func (s Sink) String() string {
+	switch s {
+	case SinkReturn:
+		return "return statement"
+
+	case SinkAssert:
+		return "type assertion"
+
+	case SinkErrorsAs:
+		return "call to errors.As"
+	}
+
+	return "unknown"
+}
+
+// Source is a concrete error value that flows into a [Sink].
+type Source struct {
+	// Value is the concrete value, before conversion to an interface.
+	Value ssa.Value
+
+	// Expr is the expression creating the value, or nil when unknown.
+	Expr ast.Expr
+
+	// Sink describes where the value is used.
+	Sink Sink
+}
+
+// Sources iterates over all concrete values converted to an error interface that reach a [Sink] in fn.
+// A value reaching multiple sinks is yielded for each of them.
+//
+// fn must be built in debug mode (see [Analyzer]) to map values to their expressions.
+func Sources(fn *ssa.Function) iter.Seq[Source] {
+	return func(yield func(Source) bool) {
+		exprs, literals := valueExprs(fn)
+		t := tracer{yield: yield, exprs: exprs, literals: literals}
+
+		for _, b := range fn.Blocks {
+			for _, instr := range b.Instrs {
+				if !t.instr(fn, instr) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// tracer follows interface values backwards to the point where they were created.
+type tracer struct {
+	yield    func(Source) bool
+	exprs    map[ssa.Value]ast.Expr
+	literals map[ssa.Value]ast.Expr
+	sink     Sink
+	visited  map[ssa.Value]struct{}
+}
+
+// instr traces the operands of instr when it is a sink.
+func (t *tracer) instr(fn *ssa.Function, instr ssa.Instruction) bool {
+	switch instr := instr.(type) {
+	case *ssa.Return:
+		results := fn.Signature.Results()
+		for i, result := range instr.Results {
+			if i < results.Len() && isErrorInterface(results.At(i).Type()) && !t.trace(SinkReturn, result) {
+				return false
+			}
+		}
+
+	case *ssa.TypeAssert:
+		if isErrorInterface(instr.X.Type()) {
+			return t.trace(SinkAssert, instr.X)
+		}
+
+	case *ssa.Call:
+		callee := instr.Call.StaticCallee()
+		if callee == nil {
+			break
+		}
+
+		fun, ok := callee.Object().(*types.Func)
+		if !ok {
+			break
+		}
+
+		index, _, ok := typeutil.ErrorAsArgs(fun)
+		if ok && index < len(instr.Call.Args) && isErrorInterface(instr.Call.Args[index].Type()) {
+			return t.trace(SinkErrorsAs, instr.Call.Args[index])
+		}
+	}
+
+	return true
+}
+
+// trace starts tracing v for the given sink.
+func (t *tracer) trace(sink Sink, v ssa.Value) bool {
+	t.sink = sink
+	clear(t.visited)
+
+	return t.iface(v)
+}
+
+// iface traces an interface value back to its concrete sources.
+func (t *tracer) iface(v ssa.Value) bool {
+	if !t.visit(v) {
+		return true
+	}
+
+	switch v := v.(type) {
+	case *ssa.MakeInterface:
+		return t.concrete(v.X)
+
+	case *ssa.ChangeInterface:
+		return t.iface(v.X)
+
+	case *ssa.Phi:
+		for _, edge := range v.Edges {
+			if !t.iface(edge) {
+				return false
+			}
+		}
+
+	case *ssa.UnOp:
+		return t.load(v)
+	}
+
+	return true
+}
+
+// concrete yields the concrete values a converted value originates from.
+func (t *tracer) concrete(v ssa.Value) bool {
+	if !t.visit(v) {
+		return true
+	}
+
+	switch v := v.(type) {
+	case *ssa.Phi:
+		for _, edge := range v.Edges {
+			if !t.concrete(edge) {
+				return false
+			}
+		}
+
+		return true
+
+	case *ssa.Const:
+		if v.IsNil() {
+			return true
+		}
+	}
+
+	return t.yield(Source{Value: v, Expr: t.expr(v), Sink: t.sink})
+}
+
+// expr returns the expression creating v. For a value loaded from a local variable
+// initialized by a single composite literal, this is the literal.
+func (t *tracer) expr(v ssa.Value) ast.Expr {
+	if load, ok := v.(*ssa.UnOp); ok && load.Op == token.MUL {
+		if lit, ok := t.literals[load.X]; ok && lit != nil && !stored(load.X) {
+			return lit
+		}
+	}
+
+	return t.exprs[v]
+}
+
+// stored reports whether a value is stored into addr as a whole, in addition to being
+// initialized in place by a composite literal.
+func stored(addr ssa.Value) bool {
+	for _, ref := range *addr.Referrers() {
+		if store, ok := ref.(*ssa.Store); ok && store.Addr == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// load follows a load from a local interface variable that could not be lifted
+// (e.g., because it is captured by a closure) to all values stored into it.
+func (t *tracer) load(v *ssa.UnOp) bool {
+	alloc, ok := v.X.(*ssa.Alloc)
+	if !ok || v.Op != token.MUL {
+		return true
+	}
+
+	for _, ref := range *alloc.Referrers() {
+		if store, ok := ref.(*ssa.Store); ok && store.Addr == alloc {
+			if !t.iface(store.Val) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// visit returns true when v has not been visited yet.
+func (t *tracer) visit(v ssa.Value) bool {
+	if t.visited == nil {
+		t.visited = make(map[ssa.Value]struct{})
+	}
+
+	if _, ok := t.visited[v]; ok {
+		return false
+	}
+
+	t.visited[v] = struct{}{}
+
+	return true
+}
+
+// isErrorInterface reports whether typ is an interface type implementing error.
+func isErrorInterface(typ types.Type) bool {
+	return types.IsInterface(typ) && typeutil.HasErrorMethod(typ)
+}
+
+// valueExprs maps values in fn to the first expression evaluating to them.
+// Expressions denoting the value itself ("&T{}") take precedence over those denoting
+// its address ("T{}" in "&T{}").
+//
+// It also maps local variables to the composite literal they are initialized with,
+// or nil when there are several.
+func valueExprs(fn *ssa.Function) (exprs, literals map[ssa.Value]ast.Expr) {
+	exprs = make(map[ssa.Value]ast.Expr)
+	literals = make(map[ssa.Value]ast.Expr)
+	addrs := make(map[ssa.Value]ast.Expr)
+
+	for _, b := range fn.Blocks {
+		for _, instr := range b.Instrs {
+			ref, ok := instr.(*ssa.DebugRef)
+			if !ok {
+				continue
+			}
+
+			if !ref.IsAddr {
+				if _, ok := exprs[ref.X]; !ok {
+					exprs[ref.X] = ref.Expr
+				}
+
+				continue
+			}
+
+			if _, ok := addrs[ref.X]; !ok {
+				addrs[ref.X] = ref.Expr
+			}
+
+			if _, ok := ref.X.(*ssa.Alloc); !ok {
+				continue
+			}
+
+			if lit, ok := ref.Expr.(*ast.CompositeLit); ok {
+				if _, seen := literals[ref.X]; seen {
+					literals[ref.X] = nil // Several literals
+				} else {
+					literals[ref.X] = lit
+				}
+			}
+		}
+	}
+
+	for v, expr := range addrs {
+		if _, ok := exprs[v]; !ok {
+			exprs[v] = expr
+		}
+	}
+
+	return exprs, literals
+}
@@ -17,6 +17,12 @@
 package overrides
 
 import (
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/goccy/go-yaml/ast"
+
 	"fillmore-labs.com/errortype/internal/errortypes"
 	"fillmore-labs.com/errortype/internal/typeutil"
 )
@@ -25,19 +31,163 @@ import (
 //
 // It categorizes type names into four groups.
 type errorfileType struct {
+	// Other override files to read first - never written.
+	Include []string `yaml:"include,omitempty"`
 	// Types that should be treated as pointer errors.
-	Pointer []typeutil.TypeName `yaml:"pointer,omitempty"`
+	Pointer []entry `yaml:"pointer,omitempty"`
 	// Types that should be treated as value errors.
-	Value []typeutil.TypeName `yaml:"value,omitempty"`
+	Value []entry `yaml:"value,omitempty"`
 	// Types for which error type checks should be suppressed - never written.
-	Suppress []typeutil.TypeName `yaml:"suppress,omitempty"`
+	Suppress []entry `yaml:"suppress,omitempty"`
 	//  Types that have inconsistent error type usage - ignored on read.
 	Inconsistent []typeutil.TypeName `yaml:"inconsistent,omitempty"`
+	// Overrides applying only to matching consuming packages - never written.
+	Scopes []scopeType `yaml:"scopes,omitempty"`
+	// Patterns matching the names of sentinel errors - never written.
+	Sentinels []string `yaml:"sentinels,omitempty"`
+	// Required usages of error types defined in the analyzed packages - never written.
+	Policy *Policy `yaml:"policy,omitempty"`
+	// Ranks of evidence, from the strongest to the weakest - never written.
+	Precedence []string `yaml:"precedence,omitempty"`
+	// Defaults for types without decisive evidence - never written.
+	Defaults []string `yaml:"defaults,omitempty"`
+}
+
+// scopeType represents a block of overrides applying only to matching consuming packages.
+type scopeType struct {
+	// Package paths or patterns, like `example.com/app/internal/legacy/...`.
+	Packages []string `yaml:"packages"`
+	// Types that should be treated as pointer errors.
+	Pointer []entry `yaml:"pointer,omitempty"`
+	// Types that should be treated as value errors.
+	Value []entry `yaml:"value,omitempty"`
+	// Types for which error type checks should be suppressed.
+	Suppress []entry `yaml:"suppress,omitempty"`
+}
+
+// entry is a type name in an override file, together with its version constraints and position.
+type entry struct {
+	typeutil.TypeName
+	versions Versions
+	pos      Position
+}
+
+// UnmarshalYAML implements [yaml.NodeUnmarshaler], recording the position of the entry.
+func (e *entry) UnmarshalYAML(node ast.Node) error {
+	var name string
+	if err := yaml.NodeToValue(node, &name); err != nil {
+		return err
+	}
+
+	if tk := node.GetToken(); tk != nil && tk.Position != nil {
+		e.pos = Position{Line: tk.Position.Line, Column: tk.Position.Column}
+	}
+
+	name, e.versions = splitVersions(name)
+
+	return e.UnmarshalText([]byte(name))
+}
+
+// splitVersions splits the version constraints from entries like `example.com/lib@<v1.5.0.ParseError`
+// or `example.com/lib@<v1.5.0/...` before the type name is parsed, since versions contain dots.
+// Entries where no type name or pattern follows the constraints are returned unchanged, to be rejected
+// as invalid type names.
+func splitVersions(name string) (string, Versions) {
+	path, rest, ok := strings.Cut(name, "@")
+	if !ok {
+		return name, ""
+	}
+
+	if versions, ok := strings.CutSuffix(rest, "/..."); ok && versions != "" {
+		return path + "/...", Versions(versions)
+	}
+
+	if i := strings.LastIndexByte(rest, '.'); i > 0 {
+		return path + rest[i:], Versions(rest[:i])
+	}
+
+	return name, ""
+}
+
+// Position is the position of an entry in an override file.
+type Position struct {
+	File   string
+	Line   int
+	Column int
+}
+
+// String returns the position like "file:line:column".
+func (p Position) String() string {
+	s := p.File
+	if s == "" {
+		s = "-"
+	}
+
+	if p.Line > 0 {
+		s += ":" + strconv.Itoa(p.Line) + ":" + strconv.Itoa(p.Column)
+	}
+
+	return s
 }
 
 // Override represents a mapping between a Go type and its associated error type.
 // It combines a TypeName with an ErrorType for error handling customization.
+//
+// Overrides written like `example.com/lib@<v1.5.0.ParseError` only apply to module versions
+// matching Versions.
 type Override struct {
 	typeutil.TypeName
 	errortypes.ErrorType
+	Versions Versions
+	Pos      Position // Position in the override file, zero for suggestions.
+	Note     string   // Comment written next to a suggestion, like its observed uses.
+}
+
+// Entry returns the override as written in the overrides file, like `example.com/lib@<v1.5.0.ParseError`
+// or `example.com/lib@<v1.5.0/...`.
+func (o Override) Entry() string {
+	name := o.TypeName.String()
+	if o.Versions == "" {
+		return name
+	}
+
+	if path, ok := strings.CutSuffix(name, "/..."); ok {
+		return path + "@" + string(o.Versions) + "/..."
+	}
+
+	return o.Path + "@" + string(o.Versions) + "." + o.Name
+}
+
+// Config represents the contents of an override file.
+type Config struct {
+	// Overrides are the error type overrides, later entries taking precedence.
+	Overrides []Override
+	// Scopes are overrides applying only to matching consuming packages.
+	Scopes []Scope
+	// Sentinels lists regular expressions matching the names of sentinel errors, if configured.
+	Sentinels []string
+	// Policy lists the required usages of error types defined in the analyzed packages, if configured.
+	Policy Policy
+	// Precedence lists the ranks of evidence, from the strongest to the weakest, if configured.
+	Precedence []string
+	// Defaults lists the defaults for types without decisive evidence, if configured.
+	Defaults []string
+}
+
+// Policy represents the required usages of error types defined in the analyzed packages.
+type Policy struct {
+	// Struct is the required usage of struct error types ("pointer", "value", or "any").
+	Struct string `yaml:"struct,omitempty"`
+	// NonStruct is the required usage of other error types ("pointer", "value", or "any").
+	NonStruct string `yaml:"nonstruct,omitempty"`
+	// Exceptions are package paths or qualified type names exempt from the policy.
+	Exceptions []string `yaml:"exceptions,omitempty"`
+}
+
+// Scope represents overrides applying only to consuming packages matching one of the package patterns.
+type Scope struct {
+	// Packages are package paths or patterns, like `example.com/app/internal/legacy/...`.
+	Packages []string
+	// Overrides are the error type overrides in the matching packages.
+	Overrides []Override
 }
@@ -17,45 +17,426 @@
 package overrides
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"go/token"
 	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 
 	"github.com/goccy/go-yaml"
+	yamlast "github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+	yamltoken "github.com/goccy/go-yaml/token"
+	"golang.org/x/mod/module"
 
 	"fillmore-labs.com/errortype/internal/errortypes"
 	"fillmore-labs.com/errortype/internal/typeutil"
 )
 
-// Read parses an override file from the provided io.Reader and returns a map
-// associating type names with their corresponding error types. The override file
-// is expected to be in YAML format and structured according to errorfileType.
-func Read(r io.Reader) ([]Override, error) {
-	dec := yaml.NewDecoder(r)
+var (
+	// ErrNoPackages is returned when a scope in an override file does not list any packages.
+	ErrNoPackages = errors.New("scope without packages")
+
+	// ErrScopedVersions is returned when an override in a scope has a version constraint.
+	ErrScopedVersions = errors.New("version constraints are not supported in scopes")
+
+	// ErrIncludeCycle is returned when override files include each other.
+	ErrIncludeCycle = errors.New("include cycle")
+
+	// ErrInvalidTypeName is returned for entries that are not valid qualified type names or patterns.
+	ErrInvalidTypeName = errors.New("invalid type name")
+
+	// ErrDuplicate is returned for types listed more than once with the same usage.
+	ErrDuplicate = errors.New("duplicate entry")
+
+	// ErrConflict is returned for types listed with different usages.
+	ErrConflict = errors.New("conflicting entries")
+)
+
+// Reader reads override files.
+type Reader struct {
+	// Lenient logs unknown keys and invalid, duplicate, and conflicting entries instead of failing.
+	// Unknown keys and invalid entries are skipped, and of conflicting entries the last one wins.
+	Lenient bool
+
+	// Logf logs the problems ignored by lenient reading, [log.Printf] when nil.
+	Logf func(format string, args ...any)
+}
+
+// ReadFile reads an override file and the files it includes, strictly validating the contents.
+//
+// Included files are read first, relative to the directory of the including file. Entries of a file
+// take precedence over those of its includes, and later includes take precedence over earlier ones.
+func ReadFile(name string) (Config, error) {
+	return Reader{}.ReadFile(name)
+}
+
+// Read parses an override file from the provided io.Reader, strictly validating the contents.
+// It returns the overrides associating type names with their corresponding error types, together with the
+// configured scopes, sentinel patterns, policy, precedence, and defaults. The override file is
+// expected to be in YAML format and structured according to errorfileType.
+//
+// Included files are resolved relative to the current directory.
+func Read(r io.Reader) (Config, error) {
+	return Reader{}.Read(r)
+}
+
+// ReadFile reads an override file and the files it includes, see [ReadFile].
+func (rd Reader) ReadFile(name string) (Config, error) {
+	return rd.readFile(name, make(map[string]bool))
+}
+
+// Read parses an override file from the provided io.Reader, see [Read].
+func (rd Reader) Read(r io.Reader) (Config, error) {
+	return rd.read(r, "", make(map[string]bool))
+}
+
+func (rd Reader) readFile(name string, visiting map[string]bool) (Config, error) {
+	abs, err := filepath.Abs(name)
+	if err != nil {
+		return Config{}, err
+	}
+
+	if visiting[abs] {
+		return Config{}, fmt.Errorf("%w: %s", ErrIncludeCycle, name)
+	}
+
+	visiting[abs] = true
+	defer delete(visiting, abs)
+
+	f, err := os.Open(filepath.Clean(name))
+	if err != nil {
+		return Config{}, err
+	}
+
+	defer f.Close()
+
+	return rd.read(f, name, visiting)
+}
+
+func (rd Reader) read(r io.Reader, file string, visiting map[string]bool) (Config, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Config{}, err
+	}
+
+	var opts []yaml.DecodeOption
+	if !rd.Lenient {
+		opts = append(opts, yaml.DisallowUnknownField())
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data), opts...)
 
 	var errorfile errorfileType
 	if err := dec.Decode(&errorfile); err != nil {
 		if errors.Is(err, io.EOF) {
-			return nil, nil
+			return Config{}, nil
+		}
+
+		var yerr yaml.Error
+		if errors.As(err, &yerr) {
+			return Config{}, fmt.Errorf("error parsing override file: %s: %s", tokenPosition(file, yerr.GetToken()), yerr.GetMessage())
 		}
 
-		return nil, fmt.Errorf("error parsing override file: %w", err)
+		return Config{}, fmt.Errorf("error parsing override file: %w", err)
 	}
 
-	errorfileMap := map[errortypes.ErrorType][]typeutil.TypeName{
-		errortypes.PointerType:  errorfile.Pointer,
-		errortypes.ValueType:    errorfile.Value,
-		errortypes.SuppressType: errorfile.Suppress,
+	if rd.Lenient {
+		rd.logUnknownKeys(file, data)
+	}
+
+	var config Config
+
+	for _, include := range errorfile.Include {
+		if !filepath.IsAbs(include) {
+			include = filepath.Join(filepath.Dir(file), include)
+		}
+
+		included, err := rd.readFile(include, visiting)
+		if err != nil {
+			return Config{}, fmt.Errorf("can't include %s: %w", include, err)
+		}
+
+		config.merge(included)
+	}
+
+	own, err := rd.newConfig(errorfile, file)
+	if err != nil {
+		return Config{}, err
+	}
+
+	config.merge(own)
+
+	return config, nil
+}
+
+// tokenPosition returns the position of a YAML token.
+func tokenPosition(file string, tk *yamltoken.Token) Position {
+	pos := Position{File: file}
+	if tk != nil && tk.Position != nil {
+		pos.Line, pos.Column = tk.Position.Line, tk.Position.Column
+	}
+
+	return pos
+}
+
+// newConfig converts a parsed override file into a [Config].
+func (rd Reader) newConfig(errorfile errorfileType, file string) (Config, error) {
+	overrides, err := rd.collectOverrides(file, errorfile.Pointer, errorfile.Value, errorfile.Suppress)
+	if err != nil {
+		return Config{}, err
+	}
+
+	scopes := make([]Scope, 0, len(errorfile.Scopes))
+	for i, scope := range errorfile.Scopes {
+		if len(scope.Packages) == 0 {
+			return Config{}, fmt.Errorf("%w: scope %d", ErrNoPackages, i+1)
+		}
+
+		scopeOverrides, err := rd.collectOverrides(file, scope.Pointer, scope.Value, scope.Suppress)
+		if err != nil {
+			return Config{}, fmt.Errorf("scope %d: %w", i+1, err)
+		}
+
+		for _, override := range scopeOverrides {
+			if override.Versions != "" {
+				return Config{}, fmt.Errorf("%w: scope %d: %s", ErrScopedVersions, i+1, override.TypeName)
+			}
+		}
+
+		scopes = append(scopes, Scope{Packages: scope.Packages, Overrides: scopeOverrides})
+	}
+
+	var policy Policy
+	if errorfile.Policy != nil {
+		policy = *errorfile.Policy
+	}
+
+	return Config{
+		Overrides:  overrides,
+		Scopes:     scopes,
+		Sentinels:  errorfile.Sentinels,
+		Policy:     policy,
+		Precedence: errorfile.Precedence,
+		Defaults:   errorfile.Defaults,
+	}, nil
+}
+
+// collectOverrides combines the type names of the pointer, value, and suppress sections into overrides,
+// validating version constraints like `example.com/lib@<v1.5.0.ParseError`.
+// It validates the entries and reports duplicate and conflicting ones.
+func (rd Reader) collectOverrides(file string, pointer, value, suppress []entry) ([]Override, error) {
+	errorfileMap := [...]struct {
+		types    []entry
+		override errortypes.ErrorType
+	}{
+		{pointer, errortypes.PointerType},
+		{value, errortypes.ValueType},
+		{suppress, errortypes.SuppressType},
 		// errortypes.InconsistentType are ignored.
 	}
 
-	var overrides []Override
+	type key struct {
+		typeutil.TypeName
+		Versions
+	}
+
+	var (
+		overrides []Override
+		seen      = make(map[key]Override)
+		errs      []error
+	)
+
+	for _, section := range errorfileMap {
+		for _, e := range section.types {
+			pos := e.pos
+			pos.File = file
 
-	for override, types := range errorfileMap {
-		for _, typeName := range types {
-			overrides = append(overrides, Override{TypeName: typeName, ErrorType: override})
+			override := Override{TypeName: e.TypeName, ErrorType: section.override, Versions: e.versions, Pos: pos}
+			typeName := override.Entry()
+
+			if override.Versions != "" {
+				if err := override.Versions.Validate(); err != nil {
+					errs = append(errs, fmt.Errorf("%s: override %s: %w", pos, typeName, err))
+
+					continue
+				}
+			}
+
+			if err := validateTypeName(override.TypeName); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", pos, err))
+
+				continue
+			}
+
+			k := key{override.TypeName, override.Versions}
+			if previous, ok := seen[k]; ok {
+				if previous.ErrorType == override.ErrorType {
+					errs = append(errs, fmt.Errorf("%s: %w %s, first listed at %s", pos, ErrDuplicate, typeName, previous.Pos))
+				} else {
+					errs = append(errs, fmt.Errorf("%s: %w for %s: %s at %s, %s at %s", pos, ErrConflict, typeName,
+						strings.ToLower(previous.ErrorType.String()), previous.Pos, strings.ToLower(override.ErrorType.String()), pos))
+				}
+			}
+
+			seen[k] = override
+			overrides = append(overrides, override)
 		}
 	}
 
+	if len(errs) == 0 {
+		return overrides, nil
+	}
+
+	if !rd.Lenient {
+		return nil, errors.Join(errs...)
+	}
+
+	for _, err := range errs {
+		rd.logf("Problem in override file: %v", err)
+	}
+
 	return overrides, nil
 }
+
+// logf logs a problem ignored by lenient reading.
+func (rd Reader) logf(format string, args ...any) {
+	if rd.Logf == nil {
+		log.Printf(format, args...)
+
+		return
+	}
+
+	rd.Logf(format, args...)
+}
+
+// logUnknownKeys logs the keys of the first document of an override file ignored by lenient reading.
+func (rd Reader) logUnknownKeys(file string, data []byte) {
+	f, err := parser.ParseBytes(data, 0)
+	if err != nil || len(f.Docs) == 0 {
+		return // Already decoded successfully.
+	}
+
+	for _, problem := range unknownKeys(file, f.Docs[0].Body, reflect.TypeFor[errorfileType]()) {
+		rd.logf("Problem in override file: %s", problem)
+	}
+}
+
+// unknownKeys returns the keys of a YAML node not matching a field of the type it is decoded into,
+// like a misspelled `pointr:`, descending into the known fields.
+func unknownKeys(file string, node yamlast.Node, t reflect.Type) []string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	var problems []string
+
+	switch t.Kind() {
+	case reflect.Slice:
+		if seq, ok := node.(*yamlast.SequenceNode); ok {
+			for _, value := range seq.Values {
+				problems = append(problems, unknownKeys(file, value, t.Elem())...)
+			}
+		}
+
+	case reflect.Struct:
+		var mapping []*yamlast.MappingValueNode
+
+		switch n := node.(type) {
+		case *yamlast.MappingNode:
+			mapping = n.Values
+
+		case *yamlast.MappingValueNode:
+			mapping = []*yamlast.MappingValueNode{n}
+		}
+
+		for _, mv := range mapping {
+			tk := mv.Key.GetToken()
+
+			field, ok := yamlField(t, tk.Value)
+			if !ok {
+				problems = append(problems, fmt.Sprintf("%s: unknown field %q", tokenPosition(file, tk), tk.Value))
+
+				continue
+			}
+
+			problems = append(problems, unknownKeys(file, mv.Value, field.Type)...)
+		}
+	}
+
+	return problems
+}
+
+// yamlField returns the field of a struct type decoded from a YAML key.
+func yamlField(t reflect.Type, key string) (reflect.StructField, bool) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if name, _, _ := strings.Cut(field.Tag.Get("yaml"), ","); name == key {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+// validateTypeName checks the syntax of a qualified type name or pattern.
+func validateTypeName(typeName typeutil.TypeName) error {
+	// Replace wildcards for validation, so patterns like `example.com/.../errs.*Error` can be checked.
+	wildcards := strings.NewReplacer("...", "x", "*", "x", "?", "x")
+
+	if path, ok := strings.CutSuffix(typeName.String(), "/..."); ok {
+		// Package pattern like `example.com/errs/...`.
+		if err := module.CheckImportPath(wildcards.Replace(path)); err != nil {
+			return fmt.Errorf("%w %q: %w", ErrInvalidTypeName, typeName.String(), err)
+		}
+
+		return nil
+	}
+
+	if typeName.Path == "" {
+		return fmt.Errorf("%w %q: missing package path", ErrInvalidTypeName, typeName.String())
+	}
+
+	if err := module.CheckImportPath(wildcards.Replace(typeName.Path)); err != nil {
+		return fmt.Errorf("%w %q: %w", ErrInvalidTypeName, typeName.String(), err)
+	}
+
+	if name := wildcards.Replace(typeName.Name); !token.IsIdentifier(name) {
+		return fmt.Errorf("%w %q: %q is not an identifier", ErrInvalidTypeName, typeName.String(), typeName.Name)
+	}
+
+	return nil
+}
+
+// merge merges another configuration, which takes precedence, into c.
+func (c *Config) merge(other Config) {
+	c.Overrides = append(c.Overrides, other.Overrides...)
+	c.Scopes = append(c.Scopes, other.Scopes...)
+
+	if len(other.Sentinels) > 0 {
+		c.Sentinels = other.Sentinels
+	}
+
+	if other.Policy.Struct != "" {
+		c.Policy.Struct = other.Policy.Struct
+	}
+
+	if other.Policy.NonStruct != "" {
+		c.Policy.NonStruct = other.Policy.NonStruct
+	}
+
+	c.Policy.Exceptions = append(c.Policy.Exceptions, other.Policy.Exceptions...)
+
+	if len(other.Precedence) > 0 {
+		c.Precedence = other.Precedence
+	}
+
+	if len(other.Defaults) > 0 {
+		c.Defaults = other.Defaults
+	}
+}
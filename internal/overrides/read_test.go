@@ -0,0 +1,70 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package overrides_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	. "fillmore-labs.com/errortype/internal/overrides"
+)
+
+func TestReaderLenient(t *testing.T) {
+	t.Parallel()
+
+	data := `---
+pointr:
+  - example.com/a.AError
+value:
+  - example.com/a.VError
+  - example.com/a.Not-A-Type
+policy:
+  struct: pointer
+  nonstrukt: value
+scopes:
+  - packages: [example.com/app]
+    pointer: [example.com/a.SError]
+    valu: [example.com/a.XError]
+`
+
+	var problems []string
+
+	rd := Reader{Lenient: true, Logf: func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}}
+
+	config, err := rd.Read(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	want := []string{
+		`Problem in override file: -:2:1: unknown field "pointr"`,
+		`Problem in override file: -:9:3: unknown field "nonstrukt"`,
+		`Problem in override file: -:13:5: unknown field "valu"`,
+		`Problem in override file: -:6:5: invalid type name "example.com/a.Not-A-Type": "Not-A-Type" is not an identifier`,
+	}
+	if !slices.Equal(problems, want) {
+		t.Errorf("Read() logged\n%s\nwant\n%s", strings.Join(problems, "\n"), strings.Join(want, "\n"))
+	}
+
+	if len(config.Overrides) != 1 || config.Overrides[0].Name != "VError" {
+		t.Errorf("Read() overrides = %v, want only VError", config.Overrides)
+	}
+}
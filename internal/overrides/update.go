@@ -0,0 +1,369 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package overrides
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+
+	"fillmore-labs.com/errortype/internal/errortypes"
+)
+
+// ErrFlowStyle is returned when a section of an override file to update is written in flow style.
+var ErrFlowStyle = errors.New("flow style sections can't be updated")
+
+// ErrConsolidate is returned when a later document of an override file to update has keys other than
+// the pointer, value, suppress, and inconsistent sections.
+var ErrConsolidate = errors.New("documents with other keys can't be consolidated")
+
+// section is a section of an override file, like `pointer:`.
+type section struct {
+	keyLine int               // Line of the section key.
+	lines   map[string]int    // Lines of the entries by name.
+	notes   map[string]string // Line comments of the entries by name.
+	last    int               // Last line of the section, the key line when empty.
+	indent  string            // Indentation of the entries.
+}
+
+// pending is an entry to add to a section.
+type pending struct {
+	section string
+	name    string
+	note    string
+}
+
+// Update merges suggestions into an existing override file, keeping comments and the order of entries.
+//
+// Only the first document of an override file is read, so the entries of later documents, like suggestions
+// appended by earlier runs, are consolidated into the first document and the later documents are removed.
+//
+// Suggestions are added to the end of their section, unless the file already decides the usage of the type
+// in the pointer, value, or suppress section. Entries of the inconsistent section are removed when the file
+// decides the usage of the type, or when the type was used consistently in this run. Types not suggested
+// were not analyzed, and their entries are kept. Redundant overrides of exact type names read from this file
+// are removed, too.
+func Update(data []byte, suggestions []Override, redundant []Override) ([]byte, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		var buf bytes.Buffer
+		if err := Write(&buf, suggestions); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	}
+
+	f, err := parser.ParseBytes(data, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing override file: %w", err)
+	}
+
+	lines := strings.SplitAfter(string(data), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	end := len(lines) // Last line of the first document.
+
+	var (
+		sections map[string]*section
+		carried  []pending
+	)
+
+	for i, doc := range f.Docs {
+		docSections, err := parseSections(mappingOf(doc), lines, i > 0)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i+1, err)
+		}
+
+		if i == 0 {
+			sections = docSections
+
+			continue
+		}
+
+		if i == 1 && doc.Start != nil {
+			end = doc.Start.Position.Line - 1
+		}
+
+		for _, name := range sectionNames {
+			if s, ok := docSections[name]; ok {
+				for _, entry := range s.sorted() {
+					carried = append(carried, pending{section: name, name: entry, note: s.notes[entry]})
+				}
+			}
+		}
+	}
+
+	if sections == nil {
+		sections = make(map[string]*section)
+	}
+
+	remove, insert := planUpdate(sections, carried, suggestions, redundant)
+	for line := end + 1; line <= len(lines); line++ {
+		remove[line] = true // Consolidated into the first document.
+	}
+
+	var out strings.Builder
+
+	appendInserts := func(after int) {
+		for _, l := range insert[after] {
+			out.WriteString(l)
+		}
+	}
+
+	appendInserts(0)
+
+	for i, l := range lines {
+		line := i + 1
+
+		if line == end+1 {
+			appendInserts(-1)
+		}
+
+		if !remove[line] {
+			out.WriteString(l)
+			if !strings.HasSuffix(l, "\n") {
+				out.WriteByte('\n')
+			}
+		}
+
+		appendInserts(line)
+	}
+
+	if end == len(lines) {
+		appendInserts(-1)
+	}
+
+	return []byte(out.String()), nil
+}
+
+// sectionNames are the sections of an override file in the order they are written.
+var sectionNames = [...]string{"pointer", "value", "suppress", "inconsistent"}
+
+// mappingOf returns the top-level mapping of a document.
+func mappingOf(doc *ast.DocumentNode) []*ast.MappingValueNode {
+	switch body := doc.Body.(type) {
+	case *ast.MappingNode:
+		return body.Values
+
+	case *ast.MappingValueNode:
+		return []*ast.MappingValueNode{body}
+
+	default:
+		return nil
+	}
+}
+
+// parseSections finds the sections of a document of an override file. Other keys are an error
+// in documents to consolidate.
+func parseSections(mapping []*ast.MappingValueNode, lines []string, consolidate bool) (map[string]*section, error) {
+	sections := make(map[string]*section)
+
+	for _, mv := range mapping {
+		name := mv.Key.String()
+		keyLine := mv.Key.GetToken().Position.Line
+
+		if !slices.Contains(sectionNames[:], name) {
+			if consolidate {
+				return nil, fmt.Errorf("%w: %s at line %d", ErrConsolidate, name, keyLine)
+			}
+
+			continue
+		}
+
+		s := &section{keyLine: keyLine, lines: make(map[string]int), notes: make(map[string]string), last: keyLine, indent: "  "}
+		sections[name] = s
+
+		seq, ok := mv.Value.(*ast.SequenceNode)
+		if !ok {
+			continue // Empty section.
+		}
+
+		if seq.IsFlowStyle {
+			return nil, fmt.Errorf("%w: %s at line %d", ErrFlowStyle, name, keyLine)
+		}
+
+		for _, v := range seq.Values {
+			entry, line := v.GetToken().Value, v.GetToken().Position.Line
+			s.lines[entry] = line
+			s.last = max(s.last, line)
+
+			l := lines[line-1]
+			if strings.TrimSpace(l) != "" {
+				s.indent = l[:len(l)-len(strings.TrimLeft(l, " \t"))]
+			}
+
+			if _, note, ok := strings.Cut(l, " #"); ok {
+				s.notes[entry] = strings.TrimSpace(note)
+			}
+		}
+	}
+
+	return sections, nil
+}
+
+// sorted returns the entries of a section in file order.
+func (s *section) sorted() []string {
+	entries := make([]string, 0, len(s.lines))
+	for entry := range s.lines {
+		entries = append(entries, entry)
+	}
+
+	slices.SortFunc(entries, func(a, b string) int { return s.lines[a] - s.lines[b] })
+
+	return entries
+}
+
+// isPattern reports whether an entry is a pattern instead of an exact type name.
+func isPattern(name string) bool {
+	return strings.Contains(name, "...") || strings.ContainsAny(name, "*?")
+}
+
+// planUpdate returns the lines to remove and the lines to insert after a given line.
+// Lines inserted after line -1 are appended to the end of the first document.
+func planUpdate(sections map[string]*section, carried []pending, suggestions []Override, redundant []Override) (map[int]bool, map[int][]string) {
+	remove := make(map[int]bool)
+
+	decided := make(map[string]bool)
+	for _, name := range [...]string{"pointer", "value", "suppress"} {
+		if s, ok := sections[name]; ok {
+			for entry := range s.lines {
+				decided[entry] = true
+			}
+		}
+	}
+
+	for _, override := range redundant {
+		if isPattern(override.TypeName.String()) {
+			continue // Patterns may still apply to other types.
+		}
+
+		for _, sectionName := range [...]string{"pointer", "value"} {
+			if s, ok := sections[sectionName]; ok {
+				for name, line := range s.lines {
+					if line == override.Pos.Line {
+						remove[line] = true
+						decided[name] = true // Detected automatically, don't suggest again.
+					}
+				}
+			}
+		}
+	}
+
+	analyzed := make(map[string]bool)
+	inconsistent := make(map[string]bool)
+
+	entries := slices.Clone(carried)
+
+	for _, suggestion := range suggestions {
+		name := suggestion.TypeName.String()
+		analyzed[name] = true
+
+		sectionName := "inconsistent"
+
+		switch suggestion.ErrorType {
+		case errortypes.PointerType:
+			sectionName = "pointer"
+
+		case errortypes.ValueType:
+			sectionName = "value"
+
+		default:
+			inconsistent[name] = true
+		}
+
+		entries = append(entries, pending{section: sectionName, name: name, note: suggestion.Note})
+	}
+
+	added := make(map[string][]pending)
+	listed := make(map[string]bool)
+
+	for _, entry := range entries {
+		switch {
+		case decided[entry.name], listed[entry.section+" "+entry.name]:
+			continue
+
+		case entry.section == "inconsistent":
+			if analyzed[entry.name] && !inconsistent[entry.name] {
+				continue // Used consistently now.
+			}
+
+		default:
+			decided[entry.name] = true
+		}
+
+		listed[entry.section+" "+entry.name] = true
+
+		if s, ok := sections[entry.section]; ok {
+			if _, ok := s.lines[entry.name]; ok {
+				continue // Already listed.
+			}
+		}
+
+		added[entry.section] = append(added[entry.section], entry)
+	}
+
+	if s, ok := sections["inconsistent"]; ok {
+		for name, line := range s.lines {
+			if decided[name] || analyzed[name] && !inconsistent[name] {
+				remove[line] = true
+			}
+		}
+	}
+
+	insert := make(map[int][]string)
+
+	for _, sectionName := range sectionNames {
+		entries := added[sectionName]
+		if len(entries) == 0 {
+			continue
+		}
+
+		slices.SortFunc(entries, func(a, b pending) int { return strings.Compare(a.name, b.name) })
+
+		s, ok := sections[sectionName]
+		if !ok {
+			insert[-1] = append(insert[-1], "\n"+sectionName+":\n")
+			for _, entry := range entries {
+				insert[-1] = append(insert[-1], sequenceEntry("  ", entry.name, entry.note))
+			}
+
+			continue
+		}
+
+		for _, entry := range entries {
+			insert[s.last] = append(insert[s.last], sequenceEntry(s.indent, entry.name, entry.note))
+		}
+	}
+
+	return remove, insert
+}
+
+// sequenceEntry returns a line of a section, with an optional comment.
+func sequenceEntry(indent, name, note string) string {
+	if note == "" {
+		return indent + "- " + name + "\n"
+	}
+
+	return indent + "- " + name + " # " + note + "\n"
+}
@@ -0,0 +1,173 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package overrides_test
+
+import (
+	"errors"
+	"testing"
+
+	"fillmore-labs.com/errortype/internal/errortypes"
+	. "fillmore-labs.com/errortype/internal/overrides"
+	"fillmore-labs.com/errortype/internal/typeutil"
+)
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+
+	typeName := func(name string) typeutil.TypeName { return typeutil.TypeName{Path: "example.com/a", Name: name} }
+
+	suggestions := []Override{
+		{TypeName: typeName("AError"), ErrorType: errortypes.PointerType},
+		{TypeName: typeName("NewError"), ErrorType: errortypes.PointerType, Note: "2 pointer uses (first: a/x.go:31)"},
+		{TypeName: typeName("NewValueError"), ErrorType: errortypes.ValueType},
+		{TypeName: typeName("MixedError"), ErrorType: errortypes.Undecided},
+		{TypeName: typeName("VError"), ErrorType: errortypes.Undecided},
+	}
+
+	tests := []struct {
+		name      string
+		data      string
+		redundant []Override
+		want      string
+		wantErr   error
+	}{
+		{
+			name: "merge",
+			data: `---
+# Managed by hand.
+pointer:
+  - example.com/a.AError # keep me
+  - example.com/a.RedundantError
+# values below
+value:
+    - example.com/a.VError
+inconsistent:
+  - example.com/a.OldError
+  - example.com/a.MixedError
+  - example.com/a.NewValueError
+`,
+			redundant: []Override{{TypeName: typeName("RedundantError"), Pos: Position{Line: 5}}},
+			want: `---
+# Managed by hand.
+pointer:
+  - example.com/a.AError # keep me
+  - example.com/a.NewError # 2 pointer uses (first: a/x.go:31)
+# values below
+value:
+    - example.com/a.VError
+    - example.com/a.NewValueError
+inconsistent:
+  - example.com/a.OldError
+  - example.com/a.MixedError
+`,
+		},
+		{
+			name: "redundant pattern",
+			data: `pointer:
+  - example.com/a.*Error
+`,
+			redundant: []Override{{TypeName: typeName("*Error"), Pos: Position{Line: 2}}},
+			want: `pointer:
+  - example.com/a.*Error
+  - example.com/a.AError
+  - example.com/a.NewError # 2 pointer uses (first: a/x.go:31)
+
+value:
+  - example.com/a.NewValueError
+
+inconsistent:
+  - example.com/a.MixedError
+  - example.com/a.VError
+`,
+		},
+		{
+			name: "consolidate documents",
+			data: `---
+pointer:
+  - example.com/a.AError
+inconsistent:
+  - example.com/b.DecidedError
+---
+# Appended suggestions
+pointer:
+  - example.com/b.DecidedError # 3 pointer uses
+value:
+  - example.com/a.AError
+  - example.com/b.OtherError
+inconsistent:
+  - example.com/a.NewValueError
+  - example.com/b.UnusedError
+`,
+			want: `---
+pointer:
+  - example.com/a.AError
+  - example.com/a.NewError # 2 pointer uses (first: a/x.go:31)
+  - example.com/b.DecidedError # 3 pointer uses
+inconsistent:
+  - example.com/a.MixedError
+  - example.com/a.VError
+  - example.com/b.UnusedError
+
+value:
+  - example.com/a.NewValueError
+  - example.com/b.OtherError
+`,
+		},
+		{
+			name:    "consolidate other keys",
+			data:    "pointer:\n  - example.com/a.AError\n---\ninclude:\n  - other.yaml\n",
+			wantErr: ErrConsolidate,
+		},
+		{
+			name: "missing sections",
+			data: "# Suggestions\n",
+			want: `# Suggestions
+
+pointer:
+  - example.com/a.AError
+  - example.com/a.NewError # 2 pointer uses (first: a/x.go:31)
+
+value:
+  - example.com/a.NewValueError
+
+inconsistent:
+  - example.com/a.MixedError
+  - example.com/a.VError
+`,
+		},
+		{
+			name:    "flow style",
+			data:    "pointer: [example.com/a.AError]\n",
+			wantErr: ErrFlowStyle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Update([]byte(tt.data), suggestions, tt.redundant)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Update() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if string(got) != tt.want {
+				t.Errorf("Update() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
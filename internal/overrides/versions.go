@@ -0,0 +1,100 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package overrides
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+// ErrInvalidVersions is returned when an override has an invalid version constraint.
+var ErrInvalidVersions = errors.New("invalid version constraint")
+
+// Versions is a comma-separated list of module version constraints, like `>=v1.2.0,<v1.5.0`.
+// Each constraint is a semantic version, optionally prefixed by one of the operators
+// `<`, `<=`, `>`, `>=`, or `=`. A version without operator must match exactly.
+type Versions string
+
+// Validate checks the syntax of the version constraints.
+func (v Versions) Validate() error {
+	if v == "" {
+		return fmt.Errorf("%w: empty", ErrInvalidVersions)
+	}
+
+	for c := range strings.SplitSeq(string(v), ",") {
+		if _, version := splitOperator(c); !semver.IsValid(version) {
+			return fmt.Errorf("%w: %q", ErrInvalidVersions, c)
+		}
+	}
+
+	return nil
+}
+
+// Match reports whether the module version satisfies all constraints.
+// Unknown or invalid versions, like those of the main module, never match.
+func (v Versions) Match(version string) bool {
+	if !semver.IsValid(version) {
+		return false
+	}
+
+	for c := range strings.SplitSeq(string(v), ",") {
+		op, constraint := splitOperator(c)
+
+		cmp := semver.Compare(version, constraint)
+
+		var ok bool
+
+		switch op {
+		case "<":
+			ok = cmp < 0
+
+		case "<=":
+			ok = cmp <= 0
+
+		case ">":
+			ok = cmp > 0
+
+		case ">=":
+			ok = cmp >= 0
+
+		default:
+			ok = cmp == 0
+		}
+
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// splitOperator splits a constraint into its operator and version.
+func splitOperator(c string) (string, string) {
+	c = strings.TrimSpace(c)
+
+	for _, op := range [...]string{"<=", ">=", "<", ">", "="} {
+		if version, ok := strings.CutPrefix(c, op); ok {
+			return op, version
+		}
+	}
+
+	return "", c
+}
@@ -17,6 +17,7 @@
 package overrides
 
 import (
+	"fmt"
 	"io"
 	"slices"
 
@@ -27,27 +28,61 @@ import (
 )
 
 // Write serializes the provided overrides suggestions into YAML format and writes it to the given io.Writer.
+// Notes of suggestions are written as line comments.
 func Write(w io.Writer, suggestions []Override) error {
 	var errorfile errorfileType
 
+	notes := make(map[typeutil.TypeName]string)
+
 	for _, usage := range suggestions {
+		if usage.Note != "" {
+			notes[usage.TypeName] = usage.Note
+		}
+
 		switch usage.ErrorType {
 		case errortypes.PointerType:
-			errorfile.Pointer = append(errorfile.Pointer, usage.TypeName)
+			errorfile.Pointer = append(errorfile.Pointer, entry{TypeName: usage.TypeName})
 
 		case errortypes.ValueType:
-			errorfile.Value = append(errorfile.Value, usage.TypeName)
+			errorfile.Value = append(errorfile.Value, entry{TypeName: usage.TypeName})
 
 		default: // errortypes.SuppressType is never suggested.
 			errorfile.Inconsistent = append(errorfile.Inconsistent, usage.TypeName)
 		}
 	}
 
-	slices.SortFunc(errorfile.Pointer, typeutil.TypeName.Compare)
-	slices.SortFunc(errorfile.Value, typeutil.TypeName.Compare)
+	slices.SortFunc(errorfile.Pointer, entry.compare)
+	slices.SortFunc(errorfile.Value, entry.compare)
 	slices.SortFunc(errorfile.Inconsistent, typeutil.TypeName.Compare)
 
+	comments := make(yaml.CommentMap)
+
+	addComments := func(section string, typeNames []typeutil.TypeName) {
+		for i, typeName := range typeNames {
+			if note, ok := notes[typeName]; ok {
+				comments[fmt.Sprintf("$.%s[%d]", section, i)] = []*yaml.Comment{yaml.LineComment(" " + note)}
+			}
+		}
+	}
+
+	addComments("pointer", entryTypeNames(errorfile.Pointer))
+	addComments("value", entryTypeNames(errorfile.Value))
+	addComments("inconsistent", errorfile.Inconsistent)
+
 	_, _ = w.Write([]byte("---\n"))
 
-	return yaml.NewEncoder(w, yaml.IndentSequence(true)).Encode(errorfile)
+	return yaml.NewEncoder(w, yaml.IndentSequence(true), yaml.WithComment(comments)).Encode(errorfile)
+}
+
+// entryTypeNames returns the type names of entries.
+func entryTypeNames(entries []entry) []typeutil.TypeName {
+	typeNames := make([]typeutil.TypeName, 0, len(entries))
+	for _, e := range entries {
+		typeNames = append(typeNames, e.TypeName)
+	}
+
+	return typeNames
 }
+
+// compare compares two entries by type name.
+func (e entry) compare(other entry) int { return e.Compare(other.TypeName) }
@@ -24,7 +24,13 @@ import (
 // IsErrorAs analyzes a function call to determine if it matches patterns like errors.As and identifies the target argument.
 // It returns the resolved function and the index of its target argument, or nil, -1 if the function is not of interest.
 func IsErrorAs(info *types.Info, n *ast.CallExpr) (fun *types.Func, targetType ast.Expr, targetArgIndex int) {
-	fun, typeParams, methodExpr, ok := FuncOf(info, n.Fun)
+	return FuncValues(nil).IsErrorAs(info, n)
+}
+
+// IsErrorAs is like the package-level [IsErrorAs], but also recognizes calls through
+// function values, method values and struct fields tracked in v.
+func (v FuncValues) IsErrorAs(info *types.Info, n *ast.CallExpr) (fun *types.Func, targetType ast.Expr, targetArgIndex int) {
+	fun, typeParams, methodExpr, ok := v.FuncOf(info, n.Fun)
 	if !ok {
 		return nil, nil, -1 // Could not resolve function, might be a func variable.
 	}
@@ -60,6 +66,35 @@ func IsErrorAs(info *types.Info, n *ast.CallExpr) (fun *types.Func, targetType a
 	return fun, nil, targetArgIndex
 }
 
+// ErrorAsArgs returns the indices of the inspected error and the target argument when fun is an
+// errors.As-like function. For generic functions taking the target as a type parameter,
+// targetArgIndex is -1. For methods, the receiver counts as the first argument, as in SSA
+// call instructions.
+func ErrorAsArgs(fun *types.Func) (errArgIndex, targetArgIndex int, ok bool) {
+	funcName := FuncNameOf(fun)
+
+	target, ok := errorsAs[funcName]
+	if !ok {
+		return -1, -1, false // Not a function we are interested in.
+	}
+
+	errArgIndex, targetArgIndex = 0, -1 // Generic functions like juju/errors.AsType[T](err) take the error first.
+	if target.targetArgIndex > 0 {
+		// The error argument directly precedes the target.
+		errArgIndex, targetArgIndex = target.targetArgIndex-1, target.targetArgIndex
+	}
+
+	if funcName.Receiver != "" {
+		errArgIndex++
+
+		if targetArgIndex >= 0 {
+			targetArgIndex++
+		}
+	}
+
+	return errArgIndex, targetArgIndex, true
+}
+
 // errorsAs maps functions that behave like errors.As to the argument index
 // of their "target" parameter. This allows the analyzer to identify which
 // argument in a call to these functions should be checked for correct
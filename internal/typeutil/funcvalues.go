@@ -0,0 +1,227 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package typeutil
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// FuncValues maps variables and struct fields to the single expression assigned to them.
+//
+// It enables [FuncValues.FuncOf] to resolve calls through function values
+// ("as := errors.As; as(err, &target)"), method values ("check := r.ErrorAs")
+// and struct fields holding functions back to a [*types.Func].
+//
+// A nil entry marks a variable or field that is written more than once, has its
+// address taken, or is otherwise not statically known, like a function parameter.
+type FuncValues map[*types.Var]ast.Expr
+
+// maxFuncValueDepth limits how many variables are followed when resolving a function value.
+const maxFuncValueDepth = 8
+
+// NewFuncValues collects all writes to local variables, unexported package-level variables
+// and unexported struct fields in the given files and records those that are assigned exactly once.
+func NewFuncValues(info *types.Info, files []*ast.File) FuncValues {
+	v := make(FuncValues)
+
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.AssignStmt:
+				v.assign(info, n.Lhs, n.Rhs, n.Tok)
+
+			case *ast.ValueSpec:
+				lhs := make([]ast.Expr, len(n.Names))
+				for i, id := range n.Names {
+					lhs[i] = id
+				}
+
+				if len(n.Values) > 0 {
+					v.assign(info, lhs, n.Values, token.DEFINE)
+				}
+
+			case *ast.RangeStmt:
+				v.invalidate(info, n.Key)
+				v.invalidate(info, n.Value)
+
+			case *ast.UnaryExpr:
+				if n.Op == token.AND {
+					v.invalidate(info, n.X)
+				}
+
+			case *ast.FuncType:
+				v.invalidateFields(info, n.Params)
+				v.invalidateFields(info, n.Results)
+
+			case *ast.FuncDecl:
+				v.invalidateFields(info, n.Recv)
+
+			case *ast.CompositeLit:
+				v.compositeLit(info, n)
+			}
+
+			return true
+		})
+	}
+
+	return v
+}
+
+// assign records the assignments lhs[i] = rhs[i].
+func (v FuncValues) assign(info *types.Info, lhs, rhs []ast.Expr, tok token.Token) {
+	if len(lhs) != len(rhs) || (tok != token.ASSIGN && tok != token.DEFINE) {
+		// Multi-valued function call or operator assignment.
+		for _, l := range lhs {
+			v.invalidate(info, l)
+		}
+
+		return
+	}
+
+	for i, l := range lhs {
+		if va, ok := varOf(info, l); ok {
+			v.record(va, rhs[i])
+		}
+	}
+}
+
+// compositeLit records the struct fields initialized by a composite literal.
+func (v FuncValues) compositeLit(info *types.Info, n *ast.CompositeLit) {
+	tv, ok := info.Types[n]
+	if !ok {
+		return
+	}
+
+	st, ok := tv.Type.Underlying().(*types.Struct)
+	if !ok {
+		return // Not a struct literal.
+	}
+
+	for i, elt := range n.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			if id, ok := kv.Key.(*ast.Ident); ok {
+				if field, ok := info.Uses[id].(*types.Var); ok {
+					v.record(field, kv.Value)
+				}
+			}
+
+			continue
+		}
+
+		if i < st.NumFields() {
+			v.record(st.Field(i), elt)
+		}
+	}
+}
+
+// record notes that va has been assigned the expression value.
+func (v FuncValues) record(va *types.Var, value ast.Expr) {
+	if _, seen := v[va]; seen {
+		v[va] = nil // Assigned more than once.
+
+		return
+	}
+
+	v[va] = value
+}
+
+// invalidate marks the variable or field referenced by e as not statically known.
+func (v FuncValues) invalidate(info *types.Info, e ast.Expr) {
+	if e == nil {
+		return
+	}
+
+	if va, ok := varOf(info, e); ok {
+		v[va] = nil
+	}
+}
+
+// invalidateFields marks all variables declared in a parameter, result or receiver list as not statically known.
+func (v FuncValues) invalidateFields(info *types.Info, fields *ast.FieldList) {
+	if fields == nil {
+		return
+	}
+
+	for _, field := range fields.List {
+		for _, id := range field.Names {
+			if va, ok := info.Defs[id].(*types.Var); ok {
+				v[va] = nil
+			}
+		}
+	}
+}
+
+// varOf returns the tracked variable or struct field referenced by the expression e.
+func varOf(info *types.Info, e ast.Expr) (*types.Var, bool) {
+	switch e := ast.Unparen(e).(type) {
+	case *ast.Ident:
+		obj := info.Defs[e]
+		if obj == nil {
+			obj = info.Uses[e]
+		}
+
+		va, ok := obj.(*types.Var)
+		if !ok {
+			return nil, false
+		}
+
+		if pkg := va.Pkg(); pkg != nil && va.Parent() == pkg.Scope() && va.Exported() {
+			return nil, false // Exported package-level variables can be written by other packages.
+		}
+
+		return va, true
+
+	case *ast.SelectorExpr:
+		if sel, ok := info.Selections[e]; ok && sel.Kind() == types.FieldVal {
+			va, ok := sel.Obj().(*types.Var)
+			if !ok || va.Exported() {
+				return nil, false // Exported fields can be written by other packages.
+			}
+
+			return va, true
+		}
+	}
+
+	return nil, false
+}
+
+// FuncOf is like the package-level [FuncOf], but additionally resolves variables and struct
+// fields that are assigned a single function or method value.
+func (v FuncValues) FuncOf(info *types.Info, ex ast.Expr) (fun *types.Func, typeParams []ast.Expr, methodExpr, ok bool) {
+	for range maxFuncValueDepth {
+		fun, typeParams, methodExpr, ok = FuncOf(info, ex)
+		if ok || len(v) == 0 {
+			return fun, typeParams, methodExpr, ok
+		}
+
+		va, found := varOf(info, ex)
+		if !found {
+			return nil, nil, false, false
+		}
+
+		value := v[va]
+		if value == nil {
+			return nil, nil, false, false // Unknown or ambiguous value.
+		}
+
+		ex = value
+	}
+
+	return nil, nil, false, false
+}
@@ -0,0 +1,157 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package typeutil_test
+
+import (
+	"go/ast"
+	"testing"
+
+	. "fillmore-labs.com/errortype/internal/typeutil"
+)
+
+func TestFuncValues_FuncOf(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		src            string
+		wantFuncName   string
+		wantMethodExpr bool
+	}{
+		{
+			name: "function value",
+			src: `func myFunc() int { return 0 }
+var f = myFunc
+var _ = f()`,
+			wantFuncName: "test.myFunc",
+		},
+		{
+			name: "chained function values",
+			src: `func myFunc() int { return 0 }
+var f = myFunc
+var g = f
+var _ = g()`,
+			wantFuncName: "test.myFunc",
+		},
+		{
+			name: "method value",
+			src: `type S struct{}
+func (s S) myMethod() int { return 0 }
+var f = S{}.myMethod
+var _ = f()`,
+			wantFuncName: "(test.S).myMethod",
+		},
+		{
+			name: "method expression value",
+			src: `type S struct{}
+func (s S) myMethod() int { return 0 }
+var f = S.myMethod
+var _ = f(S{})`,
+			wantFuncName:   "(test.S).myMethod",
+			wantMethodExpr: true,
+		},
+		{
+			name: "struct field",
+			src: `func myFunc() int { return 0 }
+type S struct{ f func() int }
+var v = S{f: myFunc}
+var _ = v.f()`,
+			wantFuncName: "test.myFunc",
+		},
+		{
+			name: "positional struct field",
+			src: `func myFunc() int { return 0 }
+type S struct{ f func() int }
+var v = S{myFunc}
+var _ = v.f()`,
+			wantFuncName: "test.myFunc",
+		},
+		{
+			name: "assigned twice",
+			src: `func myFunc() int { return 0 }
+var f = myFunc
+func init() { f = func() int { return 1 } }
+var _ = f()`,
+		},
+		{
+			name: "address taken",
+			src: `func myFunc() int { return 0 }
+var f = myFunc
+var _ = &f
+var _ = f()`,
+		},
+		{
+			name: "exported variable",
+			src: `func myFunc() int { return 0 }
+var F = myFunc
+var _ = F()`,
+		},
+		{
+			name: "exported struct field",
+			src: `func myFunc() int { return 0 }
+type S struct{ F func() int }
+var v = S{F: myFunc}
+var _ = v.F()`,
+		},
+		{
+			name: "function literal",
+			src: `var f = func() int { return 0 }
+var _ = f()`,
+		},
+		{
+			name: "cyclic assignment",
+			src: `var f, g func() int
+func init() { f = g; g = f }
+var _ = f()`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			info, _, _, f := parseSource(t, tt.src)
+			callExpr := lastDeclCallExpr(f)
+
+			values := NewFuncValues(info, []*ast.File{f})
+
+			fun, _, methodExpr, ok := values.FuncOf(info, callExpr.Fun)
+
+			wantOk := tt.wantFuncName != ""
+			if ok != wantOk {
+				t.Errorf("FuncOf() ok = %v, want %v", ok, wantOk)
+			}
+
+			if !wantOk {
+				return
+			}
+
+			if fun == nil {
+				t.Fatal("FuncOf() fun is nil, but wantOk is true")
+			}
+
+			funcName := fun.FullName()
+			if funcName != tt.wantFuncName {
+				t.Errorf("FuncOf() fun.FullName() = %q, want %q", funcName, tt.wantFuncName)
+			}
+
+			if methodExpr != tt.wantMethodExpr {
+				t.Errorf("FuncOf() methodExpr = %v, want %v", methodExpr, tt.wantMethodExpr)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package typeutil
 
 import (
 	"go/ast"
+	"go/token"
 	"go/types"
 )
 
@@ -57,25 +58,57 @@ func handlePointer(p *types.Pointer) (tn *types.TypeName, isPtr, ok bool) {
 	return nil, true, false
 }
 
-// HasErrorResult checks whether the given function result list has an error type as its last return value.
-// Returns the index of the error result or -1 when not found.
-func HasErrorResult(info *types.Info, results *ast.FieldList) int {
+// ErrorResult describes a function result of an error type.
+type ErrorResult struct {
+	// Index is the position of the result in the result list.
+	Index int
+
+	// Var is the named result variable, or nil when the result is unnamed or blank.
+	Var *types.Var
+}
+
+// ErrorResults returns all results in the given function result list that are of an error type,
+// in declaration order. Error results are conventionally last, but this is not required.
+func ErrorResults(info *types.Info, results *ast.FieldList) []ErrorResult {
 	// We are only interested in functions with return values.
 	if results == nil || len(results.List) == 0 {
-		return -1 // No result
+		return nil // No result
 	}
 
-	// Only check the last return type expression, as `error` is
-	// conventionally the last one.
-	lastTypeExpr := results.List[len(results.List)-1].Type
+	var errorResults []ErrorResult
+
+	index := 0
+
+	for _, field := range results.List {
+		n := max(len(field.Names), 1)
+
+		// Check if the return type is a type with an `Error() string`` method.
+		tv, ok := info.Types[field.Type]
+		if !ok || !HasErrorMethod(tv.Type) { // including concrete types, otherwise: && types.IsInterface(tv.Type)
+			index += n
+
+			continue
+		}
+
+		if len(field.Names) == 0 {
+			errorResults = append(errorResults, ErrorResult{Index: index})
+			index++
+
+			continue
+		}
+
+		for _, name := range field.Names {
+			var v *types.Var
+			if name.Name != "_" {
+				v, _ = info.Defs[name].(*types.Var)
+			}
 
-	// Check if the return type is a type with an `Error() string`` method.
-	tv, ok := info.Types[lastTypeExpr]
-	if ok && HasErrorMethod(tv.Type) { // inclding concrete types, otherwise: && types.IsInterface(tv.Type)
-		return results.NumFields() - 1
+			errorResults = append(errorResults, ErrorResult{Index: index, Var: v})
+			index++
+		}
 	}
 
-	return -1 // Not an error type
+	return errorResults
 }
 
 // HasErrorMethod checks if a given type implements the standard `error`
@@ -123,3 +156,32 @@ func HasPointerReceiver(sig *types.Signature) (elem types.Type, isPtr bool) {
 
 	return nil, false
 }
+
+// AssignedErrorResults returns the values assigned to named error results in an assignment,
+// e.g., in deferred closures, which are returned like the results of a return statement.
+// The results map holds the named error results of the enclosing functions.
+func AssignedErrorResults(info *types.Info, n *ast.AssignStmt, results map[*types.Var]struct{}) []ast.Expr {
+	if (n.Tok != token.ASSIGN && n.Tok != token.DEFINE) || len(n.Lhs) != len(n.Rhs) {
+		return nil // Operator or multi-value assignment.
+	}
+
+	var assigned []ast.Expr
+
+	for i, lhs := range n.Lhs {
+		id, ok := ast.Unparen(lhs).(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		v, ok := info.Uses[id].(*types.Var) // Redeclared variables in := are uses.
+		if !ok {
+			continue
+		}
+
+		if _, ok := results[v]; ok {
+			assigned = append(assigned, n.Rhs[i])
+		}
+	}
+
+	return assigned
+}
@@ -18,37 +18,41 @@ package typeutil_test
 
 import (
 	"go/ast"
+	"go/types"
+	"slices"
 	"testing"
 
 	. "fillmore-labs.com/errortype/internal/typeutil"
 )
 
-func TestHasErrorResult(t *testing.T) {
+func TestErrorResults(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name      string
-		src       string
-		funcName  string
-		wantIndex int
+		name        string
+		src         string
+		funcName    string
+		wantIndices []int
+		wantNames   []string
 	}{
 		{
-			name:      "no return values",
-			src:       `func noReturn() {}`,
-			funcName:  "noReturn",
-			wantIndex: -1,
+			name:     "no return values",
+			src:      `func noReturn() {}`,
+			funcName: "noReturn",
 		},
 		{
-			name:      "single error return",
-			src:       `func singleError() error { return nil }`,
-			funcName:  "singleError",
-			wantIndex: 0,
+			name:        "single error return",
+			src:         `func singleError() error { return nil }`,
+			funcName:    "singleError",
+			wantIndices: []int{0},
+			wantNames:   []string{""},
 		},
 		{
-			name:      "multiple returns, last is error",
-			src:       `func multiReturnWithError() (int, error) { return 0, nil }`,
-			funcName:  "multiReturnWithError",
-			wantIndex: 1,
+			name:        "multiple returns, last is error",
+			src:         `func multiReturnWithError() (int, error) { return 0, nil }`,
+			funcName:    "multiReturnWithError",
+			wantIndices: []int{1},
+			wantNames:   []string{""},
 		},
 		{
 			name: "multiple returns, last is custom value error",
@@ -56,20 +60,28 @@ func TestHasErrorResult(t *testing.T) {
 type MyError struct{}
 func (e MyError) Error() string { return "my error" }
 func customError() (int, interface { error }) { return 0, MyError{} }`,
-			funcName:  "customError",
-			wantIndex: 1,
+			funcName:    "customError",
+			wantIndices: []int{1},
+			wantNames:   []string{""},
 		},
 		{
-			name:      "single return, not error",
-			src:       `func singleNonError() int { return 0 }`,
-			funcName:  "singleNonError",
-			wantIndex: -1,
+			name:     "single return, not error",
+			src:      `func singleNonError() int { return 0 }`,
+			funcName: "singleNonError",
 		},
 		{
-			name:      "multiple returns, last is not error",
-			src:       `func multiReturnNotError() (error, int) { return nil, 0 }`,
-			funcName:  "multiReturnNotError",
-			wantIndex: -1,
+			name:        "multiple returns, first is error",
+			src:         `func multiReturnNotError() (error, int) { return nil, 0 }`,
+			funcName:    "multiReturnNotError",
+			wantIndices: []int{0},
+			wantNames:   []string{""},
+		},
+		{
+			name:        "named results",
+			src:         `func named() (n int, err1, _ error, s string, err2 error) { return }`,
+			funcName:    "named",
+			wantIndices: []int{1, 2, 4},
+			wantNames:   []string{"err1", "", "err2"},
 		},
 	}
 
@@ -80,15 +92,70 @@ func customError() (int, interface { error }) { return 0, MyError{} }`,
 			info, _, _, f := parseSource(t, tt.src)
 			funcDecl := findFunc(t, f, tt.funcName)
 
-			index := HasErrorResult(info, funcDecl.Type.Results)
+			results := ErrorResults(info, funcDecl.Type.Results)
+
+			if len(results) != len(tt.wantIndices) {
+				t.Fatalf("ErrorResults() = %v, want indices %v", results, tt.wantIndices)
+			}
+
+			for i, result := range results {
+				if result.Index != tt.wantIndices[i] {
+					t.Errorf("ErrorResults()[%d].Index = %d, want %d", i, result.Index, tt.wantIndices[i])
+				}
 
-			if index != tt.wantIndex {
-				t.Errorf("HasErrorResult() index = %v, want %v", index, tt.wantIndex)
+				var name string
+				if result.Var != nil {
+					name = result.Var.Name()
+				}
+
+				if name != tt.wantNames[i] {
+					t.Errorf("ErrorResults()[%d].Var = %q, want %q", i, name, tt.wantNames[i])
+				}
 			}
 		})
 	}
 }
 
+func TestAssignedErrorResults(t *testing.T) {
+	t.Parallel()
+
+	src := `
+type E struct{}
+func (E) Error() string { return "" }
+func named() (n int, err error) {
+	defer func() { err = E{} }()
+	n, err = 1, &E{}
+	other := E{}
+	err, x := (E{}), 1
+	_, _ = other, x
+	return
+}`
+
+	info, _, _, f := parseSource(t, src)
+	funcDecl := findFunc(t, f, "named")
+
+	results := make(map[*types.Var]struct{})
+	for _, result := range ErrorResults(info, funcDecl.Type.Results) {
+		results[result.Var] = struct{}{}
+	}
+
+	var got []string
+
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		if n, ok := n.(*ast.AssignStmt); ok {
+			for _, res := range AssignedErrorResults(info, n, results) {
+				got = append(got, types.ExprString(res))
+			}
+		}
+
+		return true
+	})
+
+	if want := []string{"E{}", "&E{}", "(E{})"}; !slices.Equal(got, want) {
+		t.Errorf("AssignedErrorResults() = %q, want %q", got, want)
+	}
+}
+
 func findFunc(tb testing.TB, f *ast.File, name string) *ast.FuncDecl {
 	tb.Helper()
 
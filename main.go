@@ -17,8 +17,12 @@
 package main
 
 import (
+	"bytes"
+	"errors"
 	"flag"
 	"fmt"
+	"go/token"
+	"io/fs"
 	"log"
 	"os"
 	"path/filepath"
@@ -31,15 +35,20 @@ import (
 
 	"fillmore-labs.com/errortype/internal/analyze"
 	"fillmore-labs.com/errortype/internal/detect"
+	"fillmore-labs.com/errortype/internal/errorchain"
 	"fillmore-labs.com/errortype/internal/errortypes"
 	"fillmore-labs.com/errortype/internal/overrides"
 	"fillmore-labs.com/errortype/internal/typeutil"
 )
 
 func main() {
-	a := analyze.Analyzer
-	d := detect.Analyzer
-	analyzers := []*analysis.Analyzer{a, d}
+	vote := &detect.Vote{}
+	pins := &detect.Pins{}
+	usage := &detect.OverrideUsage{}
+	d := detect.New(detect.WithVote(vote), detect.WithPins(pins), detect.WithOverrideUsage(usage))
+	c := errorchain.New()
+	a := analyze.New(analyze.WithDetectTypes(d), analyze.WithErrorChain(c))
+	analyzers := []*analysis.Analyzer{a, d, c}
 
 	log.SetFlags(0)
 	log.SetPrefix(a.Name + ": ")
@@ -64,7 +73,7 @@ func main() {
 	}
 
 	cfg := &packages.Config{
-		Mode:  packages.LoadAllSyntax,
+		Mode:  packages.LoadAllSyntax | packages.NeedModule,
 		Tests: flags.IncludeTests,
 	}
 
@@ -90,6 +99,31 @@ func main() {
 		log.Fatal(err)
 	}
 
+	suggestions := calculateSuggestions(graph)
+
+	if flags.Vote && len(suggestions) > 0 {
+		// Re-run with the usage consistently observed in all root packages
+		// deciding types left undetermined by their defining packages.
+		vote.Set(suggestions)
+
+		graph, err = checker.Analyze(analyzers, pkgs, opts)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// Suggest the usages including the decisions of the vote.
+		suggestions = calculateSuggestions(graph)
+	}
+
+	for _, unmatched := range pins.Unmatched() {
+		log.Printf("Pinned override matches no module version in the build: %s", unmatched)
+	}
+
+	problems := usage.Problems()
+	for _, problem := range problems {
+		log.Print(problem)
+	}
+
 	// Don't print the diagnostics
 	// but apply all fixes from the root actions.
 	if flags.Fix {
@@ -117,18 +151,25 @@ func main() {
 		os.Exit(exitErr)
 	}
 
-	if err := writeSuggestions(flags.Suggest, graph); err != nil {
+	if flags.SuggestUpdate && flags.Suggest != "-" {
+		err = updateSuggestions(flags.Suggest, suggestions, problems)
+	} else {
+		err = writeSuggestions(flags.Suggest, suggestions)
+	}
+
+	if err != nil {
 		log.Fatal(err)
 	}
-}
 
-func writeSuggestions(name string, graph *checker.Graph) error {
-	if name == "" {
-		return nil
+	if flags.SuggestGo {
+		if err := writeGoSuggestions(graph); err != nil {
+			log.Fatal(err)
+		}
 	}
+}
 
-	suggestions := calculateSuggestions(graph)
-	if len(suggestions) == 0 {
+func writeSuggestions(name string, suggestions []overrides.Override) error {
+	if name == "" || len(suggestions) == 0 {
 		return nil
 	}
 
@@ -145,6 +186,55 @@ func writeSuggestions(name string, graph *checker.Graph) error {
 	return overrides.Write(out, suggestions)
 }
 
+// updateSuggestions merges the suggestions into the suggestion file, removing the overrides read
+// from it that autodetection made redundant.
+func updateSuggestions(name string, suggestions []overrides.Override, problems []detect.OverrideProblem) error {
+	if name == "" {
+		return nil
+	}
+
+	abs, err := filepath.Abs(name)
+	if err != nil {
+		return err
+	}
+
+	var redundant []overrides.Override
+
+	for _, problem := range problems {
+		if !problem.Redundant {
+			continue
+		}
+
+		if file, err := filepath.Abs(problem.Override.Pos.File); err == nil && file == abs {
+			redundant = append(redundant, problem.Override)
+		}
+	}
+
+	data, err := os.ReadFile(abs)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("can't read suggestion file: %w", err)
+	}
+
+	if len(bytes.TrimSpace(data)) == 0 && len(suggestions) == 0 {
+		return nil // Nothing to write.
+	}
+
+	updated, err := overrides.Update(data, suggestions, redundant)
+	if err != nil {
+		return fmt.Errorf("can't update suggestion file: %w", err)
+	}
+
+	if bytes.Equal(updated, data) {
+		return nil
+	}
+
+	if err := os.WriteFile(abs, updated, 0o666); err != nil { //nolint:gosec
+		return fmt.Errorf("can't write suggestion file: %w", err)
+	}
+
+	return nil
+}
+
 func calculateSuggestions(graph *checker.Graph) []overrides.Override {
 	type ErrorType uint8
 
@@ -155,9 +245,14 @@ func calculateSuggestions(graph *checker.Graph) []overrides.Override {
 	)
 
 	combined := make(map[typeutil.TypeName]ErrorType)
+	evidence := make(map[typeutil.TypeName]analyze.Evidence)
 
 	for _, root := range graph.Roots {
 		if r, ok := root.Result.(analyze.Result); ok {
+			for name, e := range r.Evidence {
+				evidence[name] = evidence[name].Merge(e)
+			}
+
 			for _, p := range r.Pointers {
 				combined[p] |= PointerType
 			}
@@ -179,17 +274,35 @@ func calculateSuggestions(graph *checker.Graph) []overrides.Override {
 	suggestions := make([]overrides.Override, 0, len(combined))
 
 	for name, usage := range combined {
+		note := relativeEvidence(evidence[name]).String()
+
 		switch usage {
 		case PointerType:
-			suggestions = append(suggestions, overrides.Override{TypeName: name, ErrorType: errortypes.PointerType})
+			suggestions = append(suggestions, overrides.Override{TypeName: name, ErrorType: errortypes.PointerType, Note: note})
 
 		case ValueType:
-			suggestions = append(suggestions, overrides.Override{TypeName: name, ErrorType: errortypes.ValueType})
+			suggestions = append(suggestions, overrides.Override{TypeName: name, ErrorType: errortypes.ValueType, Note: note})
 
 		default:
-			suggestions = append(suggestions, overrides.Override{TypeName: name, ErrorType: errortypes.Undecided})
+			suggestions = append(suggestions, overrides.Override{TypeName: name, ErrorType: errortypes.Undecided, Note: note})
 		}
 	}
 
 	return suggestions
 }
+
+// relativeEvidence returns the evidence with file names relative to the current directory, when possible.
+func relativeEvidence(e analyze.Evidence) analyze.Evidence {
+	wd, err := os.Getwd()
+	if err != nil {
+		return e
+	}
+
+	for _, pos := range []*token.Position{&e.FirstPointer, &e.FirstValue} {
+		if rel, err := filepath.Rel(wd, pos.Filename); err == nil && filepath.IsLocal(rel) {
+			pos.Filename = filepath.ToSlash(rel)
+		}
+	}
+
+	return e
+}
@@ -0,0 +1,402 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis/checker"
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/packages"
+
+	"fillmore-labs.com/errortype/internal/analyze"
+	"fillmore-labs.com/errortype/internal/detect"
+	"fillmore-labs.com/errortype/internal/errortypes"
+	"fillmore-labs.com/errortype/internal/typeutil"
+)
+
+// goSuggestionsFile is the name of the file declaring the suggested usages in each analyzed package.
+const goSuggestionsFile = "errortype_overrides.go"
+
+// packageSuggestions are the suggested usages of error types in an analyzed package.
+type packageSuggestions struct {
+	pkg      *packages.Package
+	usages   map[typeutil.TypeName]errortypes.ErrorType
+	evidence map[typeutil.TypeName]analyze.Evidence
+}
+
+// writeGoSuggestions writes or updates a file in the directory of each analyzed package, declaring
+// the usages of error types consistently observed in the package with `var _ error = ...` assertions.
+// Types used inconsistently need a decision and are not declared. Usage in test files is ignored,
+// so that the file doesn't import test-only dependencies.
+func writeGoSuggestions(graph *checker.Graph) error {
+	var errs []error
+
+	for _, s := range collectPackageSuggestions(graph) {
+		if err := s.write(); err != nil {
+			errs = append(errs, fmt.Errorf("can't write suggestions for %s: %w", s.pkg.PkgPath, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// collectPackageSuggestions returns the results of the root packages, sorted by package path.
+// Test variants and external test packages are skipped.
+func collectPackageSuggestions(graph *checker.Graph) []*packageSuggestions {
+	var suggestions []*packageSuggestions
+
+	for _, root := range graph.Roots {
+		r, ok := root.Result.(analyze.Result)
+		if !ok || root.Package.Types == nil || len(root.Package.GoFiles) == 0 || isTestPackage(root.Package) {
+			continue
+		}
+
+		s := &packageSuggestions{
+			pkg:      root.Package,
+			usages:   make(map[typeutil.TypeName]errortypes.ErrorType),
+			evidence: r.Evidence,
+		}
+
+		s.add(r.Pointers, errortypes.PointerType)
+		s.add(r.Values, errortypes.ValueType)
+		s.add(r.Inconsistent, errortypes.Undecided)
+
+		suggestions = append(suggestions, s)
+	}
+
+	slices.SortFunc(suggestions, func(a, b *packageSuggestions) int { return strings.Compare(a.pkg.PkgPath, b.pkg.PkgPath) })
+
+	return suggestions
+}
+
+// isTestPackage reports whether a package is a test variant, an external test package or a test main package.
+func isTestPackage(pkg *packages.Package) bool {
+	return strings.HasSuffix(pkg.PkgPath, "_test") || strings.HasSuffix(pkg.PkgPath, ".test") ||
+		slices.ContainsFunc(pkg.GoFiles, func(name string) bool { return strings.HasSuffix(name, "_test.go") })
+}
+
+// add records the observed usage of types.
+func (s *packageSuggestions) add(typeNames []typeutil.TypeName, errorType errortypes.ErrorType) {
+	for _, typeName := range typeNames {
+		s.usages[typeName] = errorType
+	}
+}
+
+// write adds the missing assertions to the suggestions file of the package.
+func (s *packageSuggestions) write() error {
+	name := filepath.Join(filepath.Dir(s.pkg.GoFiles[0]), goSuggestionsFile)
+
+	fset := token.NewFileSet()
+
+	src, err := os.ReadFile(name)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		src = []byte("// Usages of error types declared for the errortype linter.\n\npackage " + s.pkg.Name + "\n")
+
+	case err != nil:
+		return err
+	}
+
+	f, err := parser.ParseFile(fset, name, src, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	existing := existingAssertions(f)
+	imports := fileImports(f)
+
+	var decls []string
+
+	for _, typeName := range slices.SortedFunc(maps.Keys(s.usages), typeutil.TypeName.Compare) {
+		errorType := s.usages[typeName]
+		if errorType != errortypes.PointerType && errorType != errortypes.ValueType {
+			continue
+		}
+
+		tn, ok := s.lookup(typeName)
+		if !ok {
+			continue
+		}
+
+		if _, ok := detect.ErrorAssertion(tn, errorType, nil); !ok {
+			continue // Don't import packages of types we can't declare.
+		}
+
+		value, ok := detect.ErrorAssertion(tn, errorType, imports.qualifier(s.pkg.Types))
+		if !ok || existing[value] {
+			continue
+		}
+
+		decl := "\t_ error = " + value
+		if note := s.evidence[typeName].Counts(); note != "" {
+			decl += " // " + note
+		}
+
+		decls = append(decls, decl+"\n")
+	}
+
+	if len(decls) == 0 {
+		return nil
+	}
+
+	for _, imp := range imports.added {
+		alias := imp.name
+		if alias == path.Base(imp.path) {
+			alias = "" // Imported by its package name.
+		}
+
+		astutil.AddNamedImport(fset, f, alias, imp.path)
+	}
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, f); err != nil {
+		return err
+	}
+
+	out, err := insertAssertions(name, buf.Bytes(), decls)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(name, out, 0o666) //nolint:gosec
+}
+
+// insertAssertions adds declarations to the last `var ( ... )` block of error assertions in src,
+// or to a new block at the end of the file when there is none.
+func insertAssertions(name string, src []byte, decls []string) ([]byte, error) {
+	fset := token.NewFileSet()
+
+	f, err := parser.ParseFile(fset, name, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	if block, ok := assertionBlock(f); ok {
+		// Insert before the line of the closing parenthesis.
+		rparen := fset.Position(block.Rparen)
+		at := rparen.Offset - (rparen.Column - 1)
+
+		buf.Write(src[:at])
+
+		for _, decl := range decls {
+			buf.WriteString(decl)
+		}
+
+		buf.Write(src[at:])
+	} else {
+		buf.Write(src)
+		buf.WriteString("\nvar (\n")
+
+		for _, decl := range decls {
+			buf.WriteString(decl)
+		}
+
+		buf.WriteString(")\n")
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// assertionBlock returns the last `var ( ... )` block of a file consisting only of error assertions.
+func assertionBlock(f *ast.File) (*ast.GenDecl, bool) {
+	for _, decl := range slices.Backward(f.Decls) {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR || !gen.Lparen.IsValid() || len(gen.Specs) == 0 {
+			continue
+		}
+
+		if !slices.ContainsFunc(gen.Specs, func(spec ast.Spec) bool {
+			vs, ok := spec.(*ast.ValueSpec)
+
+			return !ok || vs.Type == nil || len(vs.Names) != 1 || vs.Names[0].Name != "_"
+		}) {
+			return gen, true
+		}
+	}
+
+	return nil, false
+}
+
+// lookup finds a type usable in the package.
+func (s *packageSuggestions) lookup(typeName typeutil.TypeName) (*types.TypeName, bool) {
+	pkg, ok := findPackage(s.pkg.Types, typeName.Path, make(map[*types.Package]bool))
+	if !ok {
+		return nil, false
+	}
+
+	tn, ok := pkg.Scope().Lookup(typeName.Name).(*types.TypeName)
+	if !ok {
+		return nil, false
+	}
+
+	if pkg != s.pkg.Types && (!tn.Exported() || pkg.Name() == "main" || !canImport(s.pkg.PkgPath, pkg.Path())) {
+		return nil, false
+	}
+
+	return tn, true
+}
+
+// findPackage finds the package with the given path among a package and its transitive imports.
+func findPackage(pkg *types.Package, path string, seen map[*types.Package]bool) (*types.Package, bool) {
+	if pkg.Path() == path {
+		return pkg, true
+	}
+
+	seen[pkg] = true
+
+	for _, imp := range pkg.Imports() {
+		if seen[imp] {
+			continue
+		}
+
+		if found, ok := findPackage(imp, path, seen); ok {
+			return found, true
+		}
+	}
+
+	return nil, false
+}
+
+// canImport reports whether the importer may import a package, following the rules for internal packages.
+func canImport(importer, path string) bool {
+	var parent string
+
+	switch i := strings.LastIndex(path, "/internal/"); {
+	case i >= 0:
+		parent = path[:i]
+
+	case strings.HasSuffix(path, "/internal"):
+		parent = strings.TrimSuffix(path, "/internal")
+
+	case path == "internal" || strings.HasPrefix(path, "internal/"):
+		return false
+
+	default:
+		return true
+	}
+
+	return importer == parent || strings.HasPrefix(importer, parent+"/")
+}
+
+// existingAssertions returns the values of the `var _ error = ...` declarations in a file.
+func existingAssertions(f *ast.File) map[string]bool {
+	existing := make(map[string]bool)
+
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			if vs, ok := spec.(*ast.ValueSpec); ok && vs.Type != nil {
+				for _, value := range vs.Values {
+					existing[types.ExprString(value)] = true
+				}
+			}
+		}
+	}
+
+	return existing
+}
+
+// importSpec is an import of the suggestions file.
+type importSpec struct {
+	name, path string
+}
+
+// importSet names the imported packages of the suggestions file.
+type importSet struct {
+	names   map[string]string // Explicit package names by path.
+	unnamed map[string]bool   // Paths imported by their package name.
+	used    map[string]bool   // Names in use.
+	added   []importSpec      // Imports to add to the file.
+}
+
+// fileImports returns the imports of a file.
+func fileImports(f *ast.File) *importSet {
+	imports := &importSet{
+		names:   make(map[string]string),
+		unnamed: make(map[string]bool),
+		used:    make(map[string]bool),
+	}
+
+	for _, spec := range f.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		switch {
+		case spec.Name == nil:
+			imports.unnamed[importPath] = true
+			imports.used[path.Base(importPath)] = true // Usually the package name.
+
+		case spec.Name.Name != "_" && spec.Name.Name != ".":
+			imports.names[importPath] = spec.Name.Name
+			imports.used[spec.Name.Name] = true
+		}
+	}
+
+	return imports
+}
+
+// qualifier returns a [types.Qualifier] naming packages by their imports, adding missing ones.
+func (imports *importSet) qualifier(current *types.Package) types.Qualifier {
+	return func(pkg *types.Package) string {
+		if pkg == current {
+			return ""
+		}
+
+		if name, ok := imports.names[pkg.Path()]; ok {
+			return name
+		}
+
+		if imports.unnamed[pkg.Path()] {
+			return pkg.Name()
+		}
+
+		name := pkg.Name()
+		for i := 2; imports.used[name] || current.Scope().Lookup(name) != nil; i++ {
+			name = pkg.Name() + strconv.Itoa(i)
+		}
+
+		imports.names[pkg.Path()] = name
+		imports.used[name] = true
+		imports.added = append(imports.added, importSpec{name: name, path: pkg.Path()})
+
+		return name
+	}
+}
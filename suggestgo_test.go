@@ -0,0 +1,154 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/checker"
+	"golang.org/x/tools/go/packages"
+
+	"fillmore-labs.com/errortype/internal/analyze"
+	"fillmore-labs.com/errortype/internal/detect"
+	"fillmore-labs.com/errortype/internal/errorchain"
+	"fillmore-labs.com/errortype/internal/typeutil"
+)
+
+func TestWriteGoSuggestions(t *testing.T) {
+	if err := typeutil.HasGo(); err != nil {
+		t.Skipf("Go not available: %s", err)
+	}
+
+	testdata, err := filepath.Abs(filepath.Join("testdata", "suggestgo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	if err := os.CopyFS(dir, os.DirFS(testdata)); err != nil {
+		t.Fatalf("can't copy test data: %v", err)
+	}
+
+	d := detect.New()
+	a := analyze.New(analyze.WithDetectTypes(d), analyze.WithErrorChain(errorchain.New()))
+
+	cfg := &packages.Config{Mode: packages.LoadAllSyntax | packages.NeedModule, Tests: true, Dir: dir}
+
+	pkgs, err := packages.Load(cfg, "./...")
+	if err != nil {
+		t.Fatalf("can't load packages: %v", err)
+	}
+
+	if n := packages.PrintErrors(pkgs); n > 0 {
+		t.Fatalf("%d errors loading packages", n)
+	}
+
+	graph, err := checker.Analyze([]*analysis.Analyzer{a}, pkgs, &checker.Options{})
+	if err != nil {
+		t.Fatalf("can't analyze packages: %v", err)
+	}
+
+	golden := goldenFiles(t, testdata)
+
+	// The second run finds all assertions declared and leaves the files unchanged.
+	for run := 1; run <= 2; run++ {
+		if err := writeGoSuggestions(graph); err != nil {
+			t.Fatalf("run %d: can't write suggestions: %v", run, err)
+		}
+
+		if got := suggestionFiles(t, dir); !slices.Equal(got, golden) {
+			t.Errorf("run %d: got suggestion files %q, expected %q", run, got, golden)
+		}
+
+		for _, name := range golden {
+			want, err := os.ReadFile(filepath.Join(testdata, name+".golden"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := os.ReadFile(filepath.Join(dir, name))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(got) != string(want) {
+				t.Errorf("run %d: %s =\n%s\nexpected\n%s", run, name, got, want)
+			}
+		}
+	}
+}
+
+// goldenFiles returns the suggestion files with golden files, relative to dir.
+func goldenFiles(t *testing.T, dir string) []string {
+	t.Helper()
+
+	var names []string
+
+	for _, name := range walkFiles(t, dir) {
+		if name, ok := strings.CutSuffix(name, ".golden"); ok {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// suggestionFiles returns the suggestion files, relative to dir.
+func suggestionFiles(t *testing.T, dir string) []string {
+	t.Helper()
+
+	var names []string
+
+	for _, name := range walkFiles(t, dir) {
+		if filepath.Base(name) == goSuggestionsFile {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// walkFiles returns the sorted names of all files in dir, relative to dir.
+func walkFiles(t *testing.T, dir string) []string {
+	t.Helper()
+
+	var names []string
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		name, err := filepath.Rel(dir, path)
+		names = append(names, name)
+
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
@@ -0,0 +1,9 @@
+// Usages of error types declared for the errortype linter.
+
+package svc
+
+import "example.com/sg/errs"
+
+var (
+	_ error = (*errs.PtrError)(nil) // Declared by hand.
+)